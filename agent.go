@@ -2,134 +2,152 @@ package space_traders_api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"io/fs"
 	"net/http"
-	"os"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
+
+	"github.com/brendoncdodd/space_traders_api/api"
 )
 
-type Agent struct {
-	AccountID       string //`json:"accountID"`
-	Credits         int    //`json:"credits"`
-	Headquarters    string //`json:"headquarters"`
-	ShipCount       int    //`json:"shipCount"`
-	StartingFaction string //`json:"startingFaction"`
-	Symbol          string //`json:"symbol"`
+// Generated from the OpenAPI document.
+type Agent = api.Agent
+
+type RegisterOptions struct {
+	Symbol  string
+	Faction string
+	// Optional. Only used for reserved symbols and reset emails.
+	Email string
+	// An account token from spacetraders.io. Optional for now but the server wants one.
+	AccountToken string
 }
 
-func (self Agent) String() string {
-	return fmt.Sprintf(
-		"Agent %s\n"+
-			"\tAccount ID:\t%s\n"+
-			"\tCredits:\t%d\n"+
-			"\tHeadquarters\t%s\n"+
-			"\tShip Count:\t%d\n"+
-			"\t(Starting) Faction:\t%s\n",
-		self.Symbol,
-		self.AccountID,
-		self.Credits,
-		self.Headquarters,
-		self.ShipCount,
-		self.StartingFaction,
-	)
+// Everything the server hands back when an agent is registered.
+type RegisterResult struct {
+	Token    string
+	Agent    *Agent
+	Contract *Contract
+	Faction  *Faction
+	Ships    []Ship
 }
 
+// Registers a new spacetraders.io agent.
+// If store isn't nil the new agent's token is saved in it as a Profile.
+func Register(options RegisterOptions, store ProfileStore) (*RegisterResult, error) {
+	errPrefix := "Trying to register agent " + options.Symbol + "."
+	respObject := new(struct {
+		Data *struct {
+			RegisterResult
+			Ship *Ship
+		}
+		Error *STJsonError
+	})
 
-// Creates a spacetraders.io agent.
-// Writes the (JSON) response body to a file: savefiles/[agent].json
-// Returns the entire response body (including the token)
-func CreateAgent(agent string, faction string) (SaveData, error) {
-	const BUFFER_SIZE = 20000
-	errPrefix := "Trying to create new agent."
-	responseBody := make([]byte, BUFFER_SIZE)
-	agentMap := make(map[string]string)
-	agentMap["symbol"] = agent
-	agentMap["faction"] = faction
+	if options.AccountToken != "" {
+		_, err := RequireAccountToken(options.AccountToken)
+		if err != nil {
+			return nil, fmt.Errorf("%s %w", errPrefix, err)
+		}
+	}
 
-	jsonObject := new(struct {
-		Data *SaveData
-	})
+	body := map[string]string{
+		"symbol":  options.Symbol,
+		"faction": options.Faction,
+	}
+	if options.Email != "" {
+		body["email"] = options.Email
+	}
 
-	agent_json_bytes, err := json.Marshal(agentMap)
+	req, err := newRequest("POST", "/register", options.AccountToken, body)
 	if err != nil {
-		return SaveData{}, fmt.Errorf("%s Marshalling JSON. %w",
-			errPrefix,
-			err,
-		)
+		return nil, fmt.Errorf("%s %w", errPrefix, err)
 	}
+	defer req.Body.Close()
 
-	resp, err := http.Post(
-		URL_base.String()+"/v2/register",
-		"application/json",
-		strings.NewReader(string(agent_json_bytes)),
-	)
+	buf, err := SendRequest(req)
 	if err != nil {
-		return SaveData{}, fmt.Errorf(
-			"%s Trying to POST new agent request.\n%s\n%w",
+		return nil, fmt.Errorf(
+			"%s Sending request.\n%w",
 			errPrefix,
-			string(agent_json_bytes),
 			err,
 		)
 	}
-	defer resp.Body.Close()
 
-	bodySize, err := resp.Body.Read(responseBody)
+	err = json.Unmarshal(buf, respObject)
 	if err != nil {
-		return SaveData{}, fmt.Errorf(
-			"%s Trying to read body of new agent request. %w",
+		return nil, fmt.Errorf(
+			"%s Unmarshaling JSON.\n%w",
 			errPrefix,
 			err,
 		)
 	}
-	responseBody = bytes.TrimRight(responseBody, "\x00")
-	responseBody = append(responseBody, byte('\n'))
-
-	err = os.WriteFile("savefiles/"+agent+".json", responseBody, fs.ModePerm)
-	if err != nil {
-		return SaveData{}, fmt.Errorf(
-			"%s Trying to write new agent file. %w",
+	if respObject.Error != nil {
+		return nil, fmt.Errorf(
+			"%s spacetraders.io error.\n%w",
 			errPrefix,
-			err,
+			respObject.Error,
 		)
 	}
-
-	if bodySize >= BUFFER_SIZE {
-		return SaveData{}, fmt.Errorf(
-			"%s Response body too big for buffer (%d bytes).",
+	if respObject.Data == nil || respObject.Data.Agent == nil || respObject.Data.Token == "" {
+		return nil, fmt.Errorf(
+			"%s %w No agent or token.",
 			errPrefix,
-			BUFFER_SIZE,
+			NoContentError,
 		)
 	}
 
-	bytes.TrimRight(responseBody, "\x00")
+	result := respObject.Data.RegisterResult
+	if respObject.Data.Ship != nil {
+		result.Ships = append([]Ship{*respObject.Data.Ship}, result.Ships...)
+	}
+
+	if store == nil {
+		return &result, nil
+	}
 
-	err = json.Unmarshal(responseBody, jsonObject)
+	profile, err := NewProfile(result.Token, options.Faction)
 	if err != nil {
-		return SaveData{}, fmt.Errorf(
-			"%s Unmarshaling JSON.\n%w",
-			errPrefix,
-			err,
-		)
+		return &result, fmt.Errorf("%s %w", errPrefix, err)
 	}
 
-	if jsonObject.Data == nil || jsonObject.Data.Agent == nil {
-		return SaveData{}, fmt.Errorf(
-			"%s Problem with SaveData, something is nil.\n",
-			errPrefix,
-		)
+	err = store.SaveProfile(profile)
+	if err != nil {
+		return &result, fmt.Errorf("%s %w", errPrefix, err)
 	}
 
-	if *jsonObject.Data == (SaveData{}) || *jsonObject.Data.Agent == (Agent{}) {
-		return *jsonObject.Data, fmt.Errorf(
-			"%s Problem with SaveData, something is empty.\n",
-			errPrefix,
-		)
+	return &result, nil
+}
+
+// Creates a spacetraders.io agent without an account token.
+// Nothing is written to disk, use Register with a ProfileStore for that.
+// Returns the new agent's token, agent, contract, faction and first ship.
+func CreateAgent(agent string, faction string) (SaveData, error) {
+	errPrefix := "Trying to create new agent."
+
+	result, err := Register(
+		RegisterOptions{Symbol: agent, Faction: faction},
+		nil,
+	)
+	if err != nil {
+		return SaveData{}, fmt.Errorf("%s %w", errPrefix, err)
+	}
+
+	saveData := SaveData{
+		Token:    result.Token,
+		Agent:    result.Agent,
+		Contract: result.Contract,
+		Faction:  result.Faction,
+	}
+	if len(result.Ships) > 0 {
+		saveData.Ship = &result.Ships[0]
 	}
 
-	return *jsonObject.Data, err
+	return saveData, nil
 }
 
 // TODO: refactor for new request pattern.
@@ -206,3 +224,190 @@ func GetAgentDetails(requestTemplate *http.Request) (Agent, string, error) {
 
 	return *JSONobject["data"], string(responseBody), err
 }
+
+// Gets one page of the agents in the game, and how many there are in total.
+// Pages start at 1. limit is capped at MAX_PAGE_LIMIT, 0 for the most per page.
+// Doesn't need a token.
+func GetAgents(page int, limit int) (agents []Agent, total int, err error) {
+	errPrefix := fmt.Sprintf("Getting agents page %d.", page)
+	respObject := new(struct {
+		Data  []Agent
+		Meta  map[string]int
+		Error *STJsonError
+	})
+
+	if page < 1 {
+		page = 1
+	}
+	if limit <= 0 || limit > MAX_PAGE_LIMIT {
+		limit = MAX_PAGE_LIMIT
+	}
+
+	req, err := newRequest("GET", "/agents", "", nil)
+	if err != nil {
+		return agents, 0, fmt.Errorf("%s %w", errPrefix, err)
+	}
+	defer req.Body.Close()
+
+	q := req.URL.Query()
+	q.Set("limit", strconv.Itoa(limit))
+	q.Set("page", strconv.Itoa(page))
+	req.URL.RawQuery = q.Encode()
+
+	buf, err := SendRequest(req)
+	if err != nil {
+		return agents, 0, fmt.Errorf(
+			"%s Sending request.\n%w",
+			errPrefix,
+			err,
+		)
+	}
+
+	err = json.Unmarshal(buf, respObject)
+	if err != nil {
+		return agents, 0, fmt.Errorf(
+			"%s Unmarshalling JSON.\n%w",
+			errPrefix,
+			err,
+		)
+	}
+	if respObject.Error != nil {
+		return agents, 0, fmt.Errorf(
+			"%s spacetraders.io error.\n%w",
+			errPrefix,
+			respObject.Error,
+		)
+	}
+
+	return respObject.Data, respObject.Meta["total"], nil
+}
+
+// https://api.spacetraders.io/v2/agents/{agentSymbol}
+// AccountID is always empty for other agents.
+func GetPublicAgent(agentSymbol string) (*Agent, error) {
+	errPrefix := "Getting agent " + agentSymbol + "."
+	respObject := new(struct {
+		Data  *Agent
+		Error *STJsonError
+	})
+
+	req, err := newRequest("GET", "/agents/"+agentSymbol, "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", errPrefix, err)
+	}
+	defer req.Body.Close()
+
+	buf, err := SendRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Sending request.\n%w",
+			errPrefix,
+			err,
+		)
+	}
+
+	err = json.Unmarshal(buf, respObject)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Unmarshaling response.\n%w",
+			errPrefix,
+			err,
+		)
+	}
+	if respObject.Error != nil {
+		return respObject.Data, fmt.Errorf(
+			"%s spacetraders.io error. %w",
+			errPrefix,
+			respObject.Error,
+		)
+	}
+	if respObject.Data == nil {
+		return nil, fmt.Errorf(
+			"%s %w",
+			errPrefix,
+			NoContentError,
+		)
+	}
+
+	return respObject.Data, nil
+}
+
+type AgentSnapshot struct {
+	Time      time.Time
+	Credits   int
+	ShipCount int
+}
+
+// Periodically records the credits and ship counts of a list of agents.
+// Series holds one time series per agent symbol, oldest first.
+type AgentWatcher struct {
+	Symbols  []string
+	Interval time.Duration
+	Series   map[string][]AgentSnapshot
+	mutex    sync.Mutex
+}
+
+func NewAgentWatcher(symbols []string, interval time.Duration) *AgentWatcher {
+	return &AgentWatcher{
+		Symbols:  symbols,
+		Interval: interval,
+		Series:   make(map[string][]AgentSnapshot),
+	}
+}
+
+// Takes one snapshot of every watched agent.
+// Keeps going if one agent fails, and returns the last error.
+func (self *AgentWatcher) Snapshot() (err error) {
+	now := time.Now()
+
+	for _, symbol := range self.Symbols {
+		agent, e := GetPublicAgent(symbol)
+		if e != nil {
+			err = fmt.Errorf("Taking agent snapshot. %w", e)
+			continue
+		}
+
+		self.mutex.Lock()
+		self.Series[symbol] = append(self.Series[symbol], AgentSnapshot{
+			Time:      now,
+			Credits:   agent.Credits,
+			ShipCount: agent.ShipCount,
+		})
+		self.mutex.Unlock()
+	}
+
+	return err
+}
+
+// Snapshots every Interval (default 5 minutes) until ctx is done.
+// Errors don't stop it, they're passed to onError if it isn't nil.
+func (self *AgentWatcher) Run(ctx context.Context, onError func(error)) {
+	interval := self.Interval
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		err := self.Snapshot()
+		if err != nil && onError != nil {
+			onError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// A copy of one agent's time series, safe to use while the watcher runs.
+func (self *AgentWatcher) History(symbol string) []AgentSnapshot {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	return append([]AgentSnapshot(nil), self.Series[symbol]...)
+}
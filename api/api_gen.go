@@ -0,0 +1,3692 @@
+// Code generated by openapigen from ../spec/SpaceTraders.json. DO NOT EDIT.
+
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Get Status
+// GET /
+func (self *Client) GetStatus() (*GetStatusResponse, error) {
+	var query url.Values
+	result := new(GetStatusResponse)
+	err := self.call("GET", "/", query, nil, result, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// List Agents
+// GET /agents
+func (self *Client) GetAgents(page int, limit int) (*GetAgentsResponse, error) {
+	query := url.Values{}
+	if page != 0 {
+		query.Set("page", strconv.Itoa(page))
+	}
+	if limit != 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	result := new(GetAgentsResponse)
+	err := self.call("GET", "/agents", query, nil, result, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Get Public Agent
+// GET /agents/{agentSymbol}
+func (self *Client) GetAgent(agentSymbol string) (*Agent, error) {
+	var query url.Values
+	result := new(Agent)
+	err := self.call("GET", "/agents/"+url.PathEscape(string(agentSymbol)), query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// List Factions
+// GET /factions
+func (self *Client) GetFactions(page int, limit int) (*GetFactionsResponse, error) {
+	query := url.Values{}
+	if page != 0 {
+		query.Set("page", strconv.Itoa(page))
+	}
+	if limit != 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	result := new(GetFactionsResponse)
+	err := self.call("GET", "/factions", query, nil, result, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Get Faction
+// GET /factions/{factionSymbol}
+func (self *Client) GetFaction(factionSymbol string) (*Faction, error) {
+	var query url.Values
+	result := new(Faction)
+	err := self.call("GET", "/factions/"+url.PathEscape(string(factionSymbol)), query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Get Agent
+// GET /my/agent
+func (self *Client) GetMyAgent() (*Agent, error) {
+	var query url.Values
+	result := new(Agent)
+	err := self.call("GET", "/my/agent", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// List Contracts
+// GET /my/contracts
+func (self *Client) GetContracts(page int, limit int) (*GetContractsResponse, error) {
+	query := url.Values{}
+	if page != 0 {
+		query.Set("page", strconv.Itoa(page))
+	}
+	if limit != 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	result := new(GetContractsResponse)
+	err := self.call("GET", "/my/contracts", query, nil, result, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Get Contract
+// GET /my/contracts/{contractId}
+func (self *Client) GetContract(contractID string) (*Contract, error) {
+	var query url.Values
+	result := new(Contract)
+	err := self.call("GET", "/my/contracts/"+url.PathEscape(string(contractID)), query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Accept Contract
+// POST /my/contracts/{contractId}/accept
+func (self *Client) AcceptContract(contractID string) (*AcceptContractResponse, error) {
+	var query url.Values
+	result := new(AcceptContractResponse)
+	err := self.call("POST", "/my/contracts/"+url.PathEscape(string(contractID))+"/accept", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Deliver Cargo to Contract
+// POST /my/contracts/{contractId}/deliver
+func (self *Client) DeliverContract(contractID string, body *DeliverContractRequest) (*DeliverContractResponse, error) {
+	var query url.Values
+	result := new(DeliverContractResponse)
+	err := self.call("POST", "/my/contracts/"+url.PathEscape(string(contractID))+"/deliver", query, body, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Fulfill Contract
+// POST /my/contracts/{contractId}/fulfill
+func (self *Client) FulfillContract(contractID string) (*FulfillContractResponse, error) {
+	var query url.Values
+	result := new(FulfillContractResponse)
+	err := self.call("POST", "/my/contracts/"+url.PathEscape(string(contractID))+"/fulfill", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Get My Factions
+// GET /my/factions
+func (self *Client) GetMyFactions(page int, limit int) (*GetMyFactionsResponse, error) {
+	query := url.Values{}
+	if page != 0 {
+		query.Set("page", strconv.Itoa(page))
+	}
+	if limit != 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	result := new(GetMyFactionsResponse)
+	err := self.call("GET", "/my/factions", query, nil, result, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// List Ships
+// GET /my/ships
+func (self *Client) GetMyShips(page int, limit int) (*GetMyShipsResponse, error) {
+	query := url.Values{}
+	if page != 0 {
+		query.Set("page", strconv.Itoa(page))
+	}
+	if limit != 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	result := new(GetMyShipsResponse)
+	err := self.call("GET", "/my/ships", query, nil, result, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Purchase Ship
+// POST /my/ships
+func (self *Client) PurchaseShip(body *PurchaseShipRequest) (*PurchaseShipResponse, error) {
+	var query url.Values
+	result := new(PurchaseShipResponse)
+	err := self.call("POST", "/my/ships", query, body, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Get Ship
+// GET /my/ships/{shipSymbol}
+func (self *Client) GetMyShip(shipSymbol string) (*Ship, error) {
+	var query url.Values
+	result := new(Ship)
+	err := self.call("GET", "/my/ships/"+url.PathEscape(string(shipSymbol)), query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Get Ship Cargo
+// GET /my/ships/{shipSymbol}/cargo
+func (self *Client) GetMyShipCargo(shipSymbol string) (*ShipCargo, error) {
+	var query url.Values
+	result := new(ShipCargo)
+	err := self.call("GET", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/cargo", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Create Chart
+// POST /my/ships/{shipSymbol}/chart
+func (self *Client) CreateChart(shipSymbol string) (*CreateChartResponse, error) {
+	var query url.Values
+	result := new(CreateChartResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/chart", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Get Ship Cooldown
+// GET /my/ships/{shipSymbol}/cooldown
+func (self *Client) GetShipCooldown(shipSymbol string) (*Cooldown, error) {
+	var query url.Values
+	result := new(Cooldown)
+	err := self.call("GET", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/cooldown", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Dock Ship
+// POST /my/ships/{shipSymbol}/dock
+func (self *Client) DockShip(shipSymbol string) (*DockShipResponse, error) {
+	var query url.Values
+	result := new(DockShipResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/dock", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Extract Resources
+// POST /my/ships/{shipSymbol}/extract
+func (self *Client) ExtractResources(shipSymbol string) (*ExtractResourcesResponse, error) {
+	var query url.Values
+	result := new(ExtractResourcesResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/extract", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Extract Resources with Survey
+// POST /my/ships/{shipSymbol}/extract/survey
+func (self *Client) ExtractResourcesWithSurvey(shipSymbol string, body *Survey) (*ExtractResourcesWithSurveyResponse, error) {
+	var query url.Values
+	result := new(ExtractResourcesWithSurveyResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/extract/survey", query, body, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Jettison Cargo
+// POST /my/ships/{shipSymbol}/jettison
+func (self *Client) Jettison(shipSymbol string, body *JettisonRequest) (*JettisonResponse, error) {
+	var query url.Values
+	result := new(JettisonResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/jettison", query, body, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Jump Ship
+// POST /my/ships/{shipSymbol}/jump
+func (self *Client) JumpShip(shipSymbol string, body *JumpShipRequest) (*JumpShipResponse, error) {
+	var query url.Values
+	result := new(JumpShipResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/jump", query, body, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Get Ship Modules
+// GET /my/ships/{shipSymbol}/modules
+func (self *Client) GetShipModules(shipSymbol string) (*[]ShipModule, error) {
+	var query url.Values
+	result := new([]ShipModule)
+	err := self.call("GET", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/modules", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Install Ship Module
+// POST /my/ships/{shipSymbol}/modules/install
+func (self *Client) InstallShipModule(shipSymbol string, body *InstallShipModuleRequest) (*InstallShipModuleResponse, error) {
+	var query url.Values
+	result := new(InstallShipModuleResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/modules/install", query, body, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Remove Ship Module
+// POST /my/ships/{shipSymbol}/modules/remove
+func (self *Client) RemoveShipModule(shipSymbol string, body *RemoveShipModuleRequest) (*RemoveShipModuleResponse, error) {
+	var query url.Values
+	result := new(RemoveShipModuleResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/modules/remove", query, body, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Get Mounts
+// GET /my/ships/{shipSymbol}/mounts
+func (self *Client) GetMounts(shipSymbol string) (*[]ShipMount, error) {
+	var query url.Values
+	result := new([]ShipMount)
+	err := self.call("GET", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/mounts", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Install Mount
+// POST /my/ships/{shipSymbol}/mounts/install
+func (self *Client) InstallMount(shipSymbol string, body *InstallMountRequest) (*InstallMountResponse, error) {
+	var query url.Values
+	result := new(InstallMountResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/mounts/install", query, body, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Remove Mount
+// POST /my/ships/{shipSymbol}/mounts/remove
+func (self *Client) RemoveMount(shipSymbol string, body *RemoveMountRequest) (*RemoveMountResponse, error) {
+	var query url.Values
+	result := new(RemoveMountResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/mounts/remove", query, body, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Get Ship Nav
+// GET /my/ships/{shipSymbol}/nav
+func (self *Client) GetShipNav(shipSymbol string) (*ShipNav, error) {
+	var query url.Values
+	result := new(ShipNav)
+	err := self.call("GET", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/nav", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Patch Ship Nav
+// PATCH /my/ships/{shipSymbol}/nav
+func (self *Client) PatchShipNav(shipSymbol string, body *PatchShipNavRequest) (*ShipNav, error) {
+	var query url.Values
+	result := new(ShipNav)
+	err := self.call("PATCH", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/nav", query, body, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Navigate Ship
+// POST /my/ships/{shipSymbol}/navigate
+func (self *Client) NavigateShip(shipSymbol string, body *NavigateShipRequest) (*NavigateShipResponse, error) {
+	var query url.Values
+	result := new(NavigateShipResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/navigate", query, body, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Negotiate Contract
+// POST /my/ships/{shipSymbol}/negotiate/contract
+func (self *Client) NegotiateContract(shipSymbol string) (*NegotiateContractResponse, error) {
+	var query url.Values
+	result := new(NegotiateContractResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/negotiate/contract", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Orbit Ship
+// POST /my/ships/{shipSymbol}/orbit
+func (self *Client) OrbitShip(shipSymbol string) (*OrbitShipResponse, error) {
+	var query url.Values
+	result := new(OrbitShipResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/orbit", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Purchase Cargo
+// POST /my/ships/{shipSymbol}/purchase
+func (self *Client) PurchaseCargo(shipSymbol string, body *PurchaseCargoRequest) (*PurchaseCargoResponse, error) {
+	var query url.Values
+	result := new(PurchaseCargoResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/purchase", query, body, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Ship Refine
+// POST /my/ships/{shipSymbol}/refine
+func (self *Client) ShipRefine(shipSymbol string, body *ShipRefineRequest) (*ShipRefineResponse, error) {
+	var query url.Values
+	result := new(ShipRefineResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/refine", query, body, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Refuel Ship
+// POST /my/ships/{shipSymbol}/refuel
+func (self *Client) RefuelShip(shipSymbol string, body *RefuelShipRequest) (*RefuelShipResponse, error) {
+	var query url.Values
+	result := new(RefuelShipResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/refuel", query, body, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Get Repair Ship
+// GET /my/ships/{shipSymbol}/repair
+func (self *Client) GetRepairShip(shipSymbol string) (*GetRepairShipResponse, error) {
+	var query url.Values
+	result := new(GetRepairShipResponse)
+	err := self.call("GET", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/repair", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Repair Ship
+// POST /my/ships/{shipSymbol}/repair
+func (self *Client) RepairShip(shipSymbol string) (*RepairShipResponse, error) {
+	var query url.Values
+	result := new(RepairShipResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/repair", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Scan Ships
+// POST /my/ships/{shipSymbol}/scan/ships
+func (self *Client) CreateShipShipScan(shipSymbol string) (*CreateShipShipScanResponse, error) {
+	var query url.Values
+	result := new(CreateShipShipScanResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/scan/ships", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Scan Systems
+// POST /my/ships/{shipSymbol}/scan/systems
+func (self *Client) CreateShipSystemScan(shipSymbol string) (*CreateShipSystemScanResponse, error) {
+	var query url.Values
+	result := new(CreateShipSystemScanResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/scan/systems", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Scan Waypoints
+// POST /my/ships/{shipSymbol}/scan/waypoints
+func (self *Client) CreateShipWaypointScan(shipSymbol string) (*CreateShipWaypointScanResponse, error) {
+	var query url.Values
+	result := new(CreateShipWaypointScanResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/scan/waypoints", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Get Scrap Ship
+// GET /my/ships/{shipSymbol}/scrap
+func (self *Client) GetScrapShip(shipSymbol string) (*GetScrapShipResponse, error) {
+	var query url.Values
+	result := new(GetScrapShipResponse)
+	err := self.call("GET", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/scrap", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Scrap Ship
+// POST /my/ships/{shipSymbol}/scrap
+func (self *Client) ScrapShip(shipSymbol string) (*ScrapShipResponse, error) {
+	var query url.Values
+	result := new(ScrapShipResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/scrap", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Sell Cargo
+// POST /my/ships/{shipSymbol}/sell
+func (self *Client) SellCargo(shipSymbol string, body *SellCargoRequest) (*SellCargoResponse, error) {
+	var query url.Values
+	result := new(SellCargoResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/sell", query, body, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Siphon Resources
+// POST /my/ships/{shipSymbol}/siphon
+func (self *Client) SiphonResources(shipSymbol string) (*SiphonResourcesResponse, error) {
+	var query url.Values
+	result := new(SiphonResourcesResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/siphon", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Create Survey
+// POST /my/ships/{shipSymbol}/survey
+func (self *Client) CreateSurvey(shipSymbol string) (*CreateSurveyResponse, error) {
+	var query url.Values
+	result := new(CreateSurveyResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/survey", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Transfer Cargo
+// POST /my/ships/{shipSymbol}/transfer
+func (self *Client) TransferCargo(shipSymbol string, body *TransferCargoRequest) (*TransferCargoResponse, error) {
+	var query url.Values
+	result := new(TransferCargoResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/transfer", query, body, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Warp Ship
+// POST /my/ships/{shipSymbol}/warp
+func (self *Client) WarpShip(shipSymbol string, body *WarpShipRequest) (*WarpShipResponse, error) {
+	var query url.Values
+	result := new(WarpShipResponse)
+	err := self.call("POST", "/my/ships/"+url.PathEscape(string(shipSymbol))+"/warp", query, body, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Register New Agent
+// POST /register
+func (self *Client) Register(body *RegisterRequest) (*RegisterResponse, error) {
+	var query url.Values
+	result := new(RegisterResponse)
+	err := self.call("POST", "/register", query, body, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// List Systems
+// GET /systems
+func (self *Client) GetSystems(page int, limit int) (*GetSystemsResponse, error) {
+	query := url.Values{}
+	if page != 0 {
+		query.Set("page", strconv.Itoa(page))
+	}
+	if limit != 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	result := new(GetSystemsResponse)
+	err := self.call("GET", "/systems", query, nil, result, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Get System
+// GET /systems/{systemSymbol}
+func (self *Client) GetSystem(systemSymbol string) (*System, error) {
+	var query url.Values
+	result := new(System)
+	err := self.call("GET", "/systems/"+url.PathEscape(string(systemSymbol)), query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// List Waypoints in System
+// GET /systems/{systemSymbol}/waypoints
+func (self *Client) GetSystemWaypoints(systemSymbol string, page int, limit int, typeParam WaypointType, traits []WaypointTraitSymbol) (*GetSystemWaypointsResponse, error) {
+	query := url.Values{}
+	if page != 0 {
+		query.Set("page", strconv.Itoa(page))
+	}
+	if limit != 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if typeParam != "" {
+		query.Set("type", string(typeParam))
+	}
+	for _, value := range traits {
+		query.Add("traits", string(value))
+	}
+	result := new(GetSystemWaypointsResponse)
+	err := self.call("GET", "/systems/"+url.PathEscape(string(systemSymbol))+"/waypoints", query, nil, result, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Get Waypoint
+// GET /systems/{systemSymbol}/waypoints/{waypointSymbol}
+func (self *Client) GetWaypoint(systemSymbol string, waypointSymbol string) (*Waypoint, error) {
+	var query url.Values
+	result := new(Waypoint)
+	err := self.call("GET", "/systems/"+url.PathEscape(string(systemSymbol))+"/waypoints/"+url.PathEscape(string(waypointSymbol)), query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Get Construction Site
+// GET /systems/{systemSymbol}/waypoints/{waypointSymbol}/construction
+func (self *Client) GetConstruction(systemSymbol string, waypointSymbol string) (*Construction, error) {
+	var query url.Values
+	result := new(Construction)
+	err := self.call("GET", "/systems/"+url.PathEscape(string(systemSymbol))+"/waypoints/"+url.PathEscape(string(waypointSymbol))+"/construction", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Supply Construction Site
+// POST /systems/{systemSymbol}/waypoints/{waypointSymbol}/construction/supply
+func (self *Client) SupplyConstruction(systemSymbol string, waypointSymbol string, body *SupplyConstructionRequest) (*SupplyConstructionResponse, error) {
+	var query url.Values
+	result := new(SupplyConstructionResponse)
+	err := self.call("POST", "/systems/"+url.PathEscape(string(systemSymbol))+"/waypoints/"+url.PathEscape(string(waypointSymbol))+"/construction/supply", query, body, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Get Jump Gate
+// GET /systems/{systemSymbol}/waypoints/{waypointSymbol}/jump-gate
+func (self *Client) GetJumpGate(systemSymbol string, waypointSymbol string) (*JumpGate, error) {
+	var query url.Values
+	result := new(JumpGate)
+	err := self.call("GET", "/systems/"+url.PathEscape(string(systemSymbol))+"/waypoints/"+url.PathEscape(string(waypointSymbol))+"/jump-gate", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Get Market
+// GET /systems/{systemSymbol}/waypoints/{waypointSymbol}/market
+func (self *Client) GetMarket(systemSymbol string, waypointSymbol string) (*Market, error) {
+	var query url.Values
+	result := new(Market)
+	err := self.call("GET", "/systems/"+url.PathEscape(string(systemSymbol))+"/waypoints/"+url.PathEscape(string(waypointSymbol))+"/market", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Get Shipyard
+// GET /systems/{systemSymbol}/waypoints/{waypointSymbol}/shipyard
+func (self *Client) GetShipyard(systemSymbol string, waypointSymbol string) (*Shipyard, error) {
+	var query url.Values
+	result := new(Shipyard)
+	err := self.call("GET", "/systems/"+url.PathEscape(string(systemSymbol))+"/waypoints/"+url.PathEscape(string(waypointSymbol))+"/shipyard", query, nil, result, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// The activity level of a trade good. If the good is an import, this represents how strong consumption is. If the good is an export, this represents how strong the production is for the good. When activity is strong, consumption or production is near maximum capacity. When activity is weak, consumption or production is near minimum capacity.
+type ActivityLevel string
+
+const (
+	ACTIVITY_WEAK       ActivityLevel = "WEAK"
+	ACTIVITY_GROWING    ActivityLevel = "GROWING"
+	ACTIVITY_STRONG     ActivityLevel = "STRONG"
+	ACTIVITY_RESTRICTED ActivityLevel = "RESTRICTED"
+)
+
+var knownActivityLevels = []ActivityLevel{
+	ACTIVITY_WEAK,
+	ACTIVITY_GROWING,
+	ACTIVITY_STRONG,
+	ACTIVITY_RESTRICTED,
+}
+
+// Every ActivityLevel this package knows about.
+func AllActivityLevels() []ActivityLevel {
+	return append([]ActivityLevel(nil), knownActivityLevels...)
+}
+
+func (self ActivityLevel) IsKnown() bool {
+	for _, value := range knownActivityLevels {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self ActivityLevel) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a ActivityLevel.", UnknownEnumError, string(self))
+}
+
+// Agent details.
+type Agent struct {
+	// Account ID that is tied to this agent. Only included on your own agent.
+	AccountID string `json:"accountId,omitempty"`
+	// The number of credits the agent has available. Credits can be negative if funds have been overdrawn.
+	Credits int `json:"credits"`
+	// The headquarters of the agent.
+	Headquarters string `json:"headquarters"`
+	// How many ships are owned by the agent.
+	ShipCount int `json:"shipCount"`
+	// The faction the agent started with.
+	StartingFaction string `json:"startingFaction"`
+	// Symbol of the agent.
+	Symbol string `json:"symbol"`
+}
+
+// The chart of a system or waypoint, which makes the location visible to other agents.
+type Chart struct {
+	// The agent that submitted the chart for this waypoint.
+	SubmittedBy string `json:"submittedBy,omitempty"`
+	// The time the chart for this waypoint was submitted.
+	SubmittedOn    string         `json:"submittedOn,omitempty"`
+	WaypointSymbol WaypointSymbol `json:"waypointSymbol,omitempty"`
+}
+
+// The construction details of a waypoint.
+type Construction struct {
+	// Whether the waypoint has been constructed.
+	IsComplete bool `json:"isComplete"`
+	// The materials required to construct the waypoint.
+	Materials []ConstructionMaterial `json:"materials"`
+	// The symbol of the waypoint.
+	Symbol string `json:"symbol"`
+}
+
+// The details of the required construction materials for a given waypoint under construction.
+type ConstructionMaterial struct {
+	// The number of units fulfilled toward the required amount.
+	Fulfilled int `json:"fulfilled"`
+	// The number of units required.
+	Required    int         `json:"required"`
+	TradeSymbol TradeSymbol `json:"tradeSymbol"`
+}
+
+// Contract details.
+type Contract struct {
+	// Whether the contract has been accepted by the agent
+	Accepted bool `json:"accepted"`
+	// The time at which the contract is no longer available to be accepted
+	DeadlineToAccept string `json:"deadlineToAccept,omitempty"`
+	// Deprecated in favor of deadlineToAccept
+	Expiration string `json:"expiration"`
+	// The symbol of the faction that this contract is for.
+	FactionSymbol string `json:"factionSymbol"`
+	// Whether the contract has been fulfilled
+	Fulfilled bool `json:"fulfilled"`
+	// ID of the contract.
+	ID    string         `json:"id"`
+	Terms *ContractTerms `json:"terms"`
+	// Type of contract.
+	Type ContractType `json:"type"`
+}
+
+// The details of a delivery contract. Includes the type of good, units needed, and the destination.
+type ContractDeliverGood struct {
+	// The destination where goods need to be delivered.
+	DestinationSymbol string `json:"destinationSymbol"`
+	// The symbol of the trade good to deliver.
+	TradeSymbol string `json:"tradeSymbol"`
+	// The number of units fulfilled on this contract.
+	UnitsFulfilled int `json:"unitsFulfilled"`
+	// The number of units that need to be delivered on this contract.
+	UnitsRequired int `json:"unitsRequired"`
+}
+
+// Payments for the contract.
+type ContractPayment struct {
+	// The amount of credits received up front for accepting the contract.
+	OnAccepted int `json:"onAccepted"`
+	// The amount of credits received when the contract is fulfilled.
+	OnFulfilled int `json:"onFulfilled"`
+}
+
+// The terms to fulfill the contract.
+type ContractTerms struct {
+	// The deadline for the contract.
+	Deadline string `json:"deadline"`
+	// The cargo that needs to be delivered to fulfill the contract.
+	Deliver []ContractDeliverGood `json:"deliver,omitempty"`
+	Payment *ContractPayment      `json:"payment"`
+}
+
+// A cooldown is a period of time in which a ship cannot perform certain actions.
+type Cooldown struct {
+	// The date and time when the cooldown expires in ISO 8601 format
+	Expiration string `json:"expiration,omitempty"`
+	// The remaining duration of the cooldown in seconds
+	RemainingSeconds int `json:"remainingSeconds"`
+	// The symbol of the ship that is on cooldown
+	ShipSymbol string `json:"shipSymbol"`
+	// The total duration of the cooldown in seconds
+	TotalSeconds int `json:"totalSeconds"`
+}
+
+// Extraction details.
+type Extraction struct {
+	// Symbol of the ship that executed the extraction.
+	ShipSymbol string           `json:"shipSymbol"`
+	Yield      *ExtractionYield `json:"yield"`
+}
+
+// A yield from the extraction operation.
+type ExtractionYield struct {
+	Symbol TradeSymbol `json:"symbol"`
+	// The number of units extracted that were placed into the ship's cargo hold.
+	Units int `json:"units"`
+}
+
+// Faction details.
+type Faction struct {
+	// Description of the faction.
+	Description string `json:"description"`
+	// The waypoint in which the faction's HQ is located in.
+	Headquarters string `json:"headquarters"`
+	// Whether or not the faction is currently recruiting new agents.
+	IsRecruiting bool `json:"isRecruiting"`
+	// Name of the faction.
+	Name   string        `json:"name"`
+	Symbol FactionSymbol `json:"symbol"`
+	// List of traits that define this faction.
+	Traits []FactionTrait `json:"traits"`
+}
+
+// The symbol of the faction.
+type FactionSymbol string
+
+const (
+	FACTION_COSMIC   FactionSymbol = "COSMIC"
+	FACTION_VOID     FactionSymbol = "VOID"
+	FACTION_GALACTIC FactionSymbol = "GALACTIC"
+	FACTION_QUANTUM  FactionSymbol = "QUANTUM"
+	FACTION_DOMINION FactionSymbol = "DOMINION"
+	FACTION_ASTRO    FactionSymbol = "ASTRO"
+	FACTION_CORSAIRS FactionSymbol = "CORSAIRS"
+	FACTION_OBSIDIAN FactionSymbol = "OBSIDIAN"
+	FACTION_AEGIS    FactionSymbol = "AEGIS"
+	FACTION_UNITED   FactionSymbol = "UNITED"
+	FACTION_SOLITARY FactionSymbol = "SOLITARY"
+	FACTION_COBALT   FactionSymbol = "COBALT"
+	FACTION_OMEGA    FactionSymbol = "OMEGA"
+	FACTION_ECHO     FactionSymbol = "ECHO"
+	FACTION_LORDS    FactionSymbol = "LORDS"
+	FACTION_CULT     FactionSymbol = "CULT"
+	FACTION_ANCIENTS FactionSymbol = "ANCIENTS"
+	FACTION_SHADOW   FactionSymbol = "SHADOW"
+	FACTION_ETHEREAL FactionSymbol = "ETHEREAL"
+)
+
+var knownFactionSymbols = []FactionSymbol{
+	FACTION_COSMIC,
+	FACTION_VOID,
+	FACTION_GALACTIC,
+	FACTION_QUANTUM,
+	FACTION_DOMINION,
+	FACTION_ASTRO,
+	FACTION_CORSAIRS,
+	FACTION_OBSIDIAN,
+	FACTION_AEGIS,
+	FACTION_UNITED,
+	FACTION_SOLITARY,
+	FACTION_COBALT,
+	FACTION_OMEGA,
+	FACTION_ECHO,
+	FACTION_LORDS,
+	FACTION_CULT,
+	FACTION_ANCIENTS,
+	FACTION_SHADOW,
+	FACTION_ETHEREAL,
+}
+
+// Every FactionSymbol this package knows about.
+func AllFactionSymbols() []FactionSymbol {
+	return append([]FactionSymbol(nil), knownFactionSymbols...)
+}
+
+func (self FactionSymbol) IsKnown() bool {
+	for _, value := range knownFactionSymbols {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self FactionSymbol) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a FactionSymbol.", UnknownEnumError, string(self))
+}
+
+type FactionTrait struct {
+	// A description of the trait.
+	Description string `json:"description"`
+	// The name of the trait.
+	Name   string             `json:"name"`
+	Symbol FactionTraitSymbol `json:"symbol"`
+}
+
+// The unique identifier of the trait.
+type FactionTraitSymbol string
+
+const (
+	FACTION_TRAIT_BUREAUCRATIC             FactionTraitSymbol = "BUREAUCRATIC"
+	FACTION_TRAIT_SECRETIVE                FactionTraitSymbol = "SECRETIVE"
+	FACTION_TRAIT_CAPITALISTIC             FactionTraitSymbol = "CAPITALISTIC"
+	FACTION_TRAIT_INDUSTRIOUS              FactionTraitSymbol = "INDUSTRIOUS"
+	FACTION_TRAIT_PEACEFUL                 FactionTraitSymbol = "PEACEFUL"
+	FACTION_TRAIT_DISTRUSTFUL              FactionTraitSymbol = "DISTRUSTFUL"
+	FACTION_TRAIT_WELCOMING                FactionTraitSymbol = "WELCOMING"
+	FACTION_TRAIT_SMUGGLERS                FactionTraitSymbol = "SMUGGLERS"
+	FACTION_TRAIT_SCAVENGERS               FactionTraitSymbol = "SCAVENGERS"
+	FACTION_TRAIT_REBELLIOUS               FactionTraitSymbol = "REBELLIOUS"
+	FACTION_TRAIT_EXILES                   FactionTraitSymbol = "EXILES"
+	FACTION_TRAIT_PIRATES                  FactionTraitSymbol = "PIRATES"
+	FACTION_TRAIT_RAIDERS                  FactionTraitSymbol = "RAIDERS"
+	FACTION_TRAIT_CLAN                     FactionTraitSymbol = "CLAN"
+	FACTION_TRAIT_GUILD                    FactionTraitSymbol = "GUILD"
+	FACTION_TRAIT_DOMINION                 FactionTraitSymbol = "DOMINION"
+	FACTION_TRAIT_FRINGE                   FactionTraitSymbol = "FRINGE"
+	FACTION_TRAIT_FORSAKEN                 FactionTraitSymbol = "FORSAKEN"
+	FACTION_TRAIT_ISOLATED                 FactionTraitSymbol = "ISOLATED"
+	FACTION_TRAIT_LOCALIZED                FactionTraitSymbol = "LOCALIZED"
+	FACTION_TRAIT_ESTABLISHED              FactionTraitSymbol = "ESTABLISHED"
+	FACTION_TRAIT_NOTABLE                  FactionTraitSymbol = "NOTABLE"
+	FACTION_TRAIT_DOMINANT                 FactionTraitSymbol = "DOMINANT"
+	FACTION_TRAIT_INESCAPABLE              FactionTraitSymbol = "INESCAPABLE"
+	FACTION_TRAIT_INNOVATIVE               FactionTraitSymbol = "INNOVATIVE"
+	FACTION_TRAIT_BOLD                     FactionTraitSymbol = "BOLD"
+	FACTION_TRAIT_VISIONARY                FactionTraitSymbol = "VISIONARY"
+	FACTION_TRAIT_CURIOUS                  FactionTraitSymbol = "CURIOUS"
+	FACTION_TRAIT_DARING                   FactionTraitSymbol = "DARING"
+	FACTION_TRAIT_EXPLORATORY              FactionTraitSymbol = "EXPLORATORY"
+	FACTION_TRAIT_RESOURCEFUL              FactionTraitSymbol = "RESOURCEFUL"
+	FACTION_TRAIT_FLEXIBLE                 FactionTraitSymbol = "FLEXIBLE"
+	FACTION_TRAIT_COOPERATIVE              FactionTraitSymbol = "COOPERATIVE"
+	FACTION_TRAIT_UNITED                   FactionTraitSymbol = "UNITED"
+	FACTION_TRAIT_STRATEGIC                FactionTraitSymbol = "STRATEGIC"
+	FACTION_TRAIT_INTELLIGENT              FactionTraitSymbol = "INTELLIGENT"
+	FACTION_TRAIT_RESEARCH_FOCUSED         FactionTraitSymbol = "RESEARCH_FOCUSED"
+	FACTION_TRAIT_COLLABORATIVE            FactionTraitSymbol = "COLLABORATIVE"
+	FACTION_TRAIT_PROGRESSIVE              FactionTraitSymbol = "PROGRESSIVE"
+	FACTION_TRAIT_MILITARISTIC             FactionTraitSymbol = "MILITARISTIC"
+	FACTION_TRAIT_TECHNOLOGICALLY_ADVANCED FactionTraitSymbol = "TECHNOLOGICALLY_ADVANCED"
+	FACTION_TRAIT_AGGRESSIVE               FactionTraitSymbol = "AGGRESSIVE"
+	FACTION_TRAIT_IMPERIALISTIC            FactionTraitSymbol = "IMPERIALISTIC"
+	FACTION_TRAIT_TREASURE_HUNTERS         FactionTraitSymbol = "TREASURE_HUNTERS"
+	FACTION_TRAIT_DEXTEROUS                FactionTraitSymbol = "DEXTEROUS"
+	FACTION_TRAIT_UNPREDICTABLE            FactionTraitSymbol = "UNPREDICTABLE"
+	FACTION_TRAIT_BRUTAL                   FactionTraitSymbol = "BRUTAL"
+	FACTION_TRAIT_FLEETING                 FactionTraitSymbol = "FLEETING"
+	FACTION_TRAIT_ADAPTABLE                FactionTraitSymbol = "ADAPTABLE"
+	FACTION_TRAIT_SELF_SUFFICIENT          FactionTraitSymbol = "SELF_SUFFICIENT"
+	FACTION_TRAIT_DEFENSIVE                FactionTraitSymbol = "DEFENSIVE"
+	FACTION_TRAIT_PROUD                    FactionTraitSymbol = "PROUD"
+	FACTION_TRAIT_DIVERSE                  FactionTraitSymbol = "DIVERSE"
+	FACTION_TRAIT_INDEPENDENT              FactionTraitSymbol = "INDEPENDENT"
+	FACTION_TRAIT_SELF_INTERESTED          FactionTraitSymbol = "SELF_INTERESTED"
+	FACTION_TRAIT_FRAGMENTED               FactionTraitSymbol = "FRAGMENTED"
+	FACTION_TRAIT_COMMERCIAL               FactionTraitSymbol = "COMMERCIAL"
+	FACTION_TRAIT_FREE_MARKETS             FactionTraitSymbol = "FREE_MARKETS"
+	FACTION_TRAIT_ENTREPRENEURIAL          FactionTraitSymbol = "ENTREPRENEURIAL"
+)
+
+var knownFactionTraitSymbols = []FactionTraitSymbol{
+	FACTION_TRAIT_BUREAUCRATIC,
+	FACTION_TRAIT_SECRETIVE,
+	FACTION_TRAIT_CAPITALISTIC,
+	FACTION_TRAIT_INDUSTRIOUS,
+	FACTION_TRAIT_PEACEFUL,
+	FACTION_TRAIT_DISTRUSTFUL,
+	FACTION_TRAIT_WELCOMING,
+	FACTION_TRAIT_SMUGGLERS,
+	FACTION_TRAIT_SCAVENGERS,
+	FACTION_TRAIT_REBELLIOUS,
+	FACTION_TRAIT_EXILES,
+	FACTION_TRAIT_PIRATES,
+	FACTION_TRAIT_RAIDERS,
+	FACTION_TRAIT_CLAN,
+	FACTION_TRAIT_GUILD,
+	FACTION_TRAIT_DOMINION,
+	FACTION_TRAIT_FRINGE,
+	FACTION_TRAIT_FORSAKEN,
+	FACTION_TRAIT_ISOLATED,
+	FACTION_TRAIT_LOCALIZED,
+	FACTION_TRAIT_ESTABLISHED,
+	FACTION_TRAIT_NOTABLE,
+	FACTION_TRAIT_DOMINANT,
+	FACTION_TRAIT_INESCAPABLE,
+	FACTION_TRAIT_INNOVATIVE,
+	FACTION_TRAIT_BOLD,
+	FACTION_TRAIT_VISIONARY,
+	FACTION_TRAIT_CURIOUS,
+	FACTION_TRAIT_DARING,
+	FACTION_TRAIT_EXPLORATORY,
+	FACTION_TRAIT_RESOURCEFUL,
+	FACTION_TRAIT_FLEXIBLE,
+	FACTION_TRAIT_COOPERATIVE,
+	FACTION_TRAIT_UNITED,
+	FACTION_TRAIT_STRATEGIC,
+	FACTION_TRAIT_INTELLIGENT,
+	FACTION_TRAIT_RESEARCH_FOCUSED,
+	FACTION_TRAIT_COLLABORATIVE,
+	FACTION_TRAIT_PROGRESSIVE,
+	FACTION_TRAIT_MILITARISTIC,
+	FACTION_TRAIT_TECHNOLOGICALLY_ADVANCED,
+	FACTION_TRAIT_AGGRESSIVE,
+	FACTION_TRAIT_IMPERIALISTIC,
+	FACTION_TRAIT_TREASURE_HUNTERS,
+	FACTION_TRAIT_DEXTEROUS,
+	FACTION_TRAIT_UNPREDICTABLE,
+	FACTION_TRAIT_BRUTAL,
+	FACTION_TRAIT_FLEETING,
+	FACTION_TRAIT_ADAPTABLE,
+	FACTION_TRAIT_SELF_SUFFICIENT,
+	FACTION_TRAIT_DEFENSIVE,
+	FACTION_TRAIT_PROUD,
+	FACTION_TRAIT_DIVERSE,
+	FACTION_TRAIT_INDEPENDENT,
+	FACTION_TRAIT_SELF_INTERESTED,
+	FACTION_TRAIT_FRAGMENTED,
+	FACTION_TRAIT_COMMERCIAL,
+	FACTION_TRAIT_FREE_MARKETS,
+	FACTION_TRAIT_ENTREPRENEURIAL,
+}
+
+// Every FactionTraitSymbol this package knows about.
+func AllFactionTraitSymbols() []FactionTraitSymbol {
+	return append([]FactionTraitSymbol(nil), knownFactionTraitSymbols...)
+}
+
+func (self FactionTraitSymbol) IsKnown() bool {
+	for _, value := range knownFactionTraitSymbols {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self FactionTraitSymbol) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a FactionTraitSymbol.", UnknownEnumError, string(self))
+}
+
+type JumpGate struct {
+	// All the gates that are connected to this waypoint.
+	Connections []string       `json:"connections"`
+	Symbol      WaypointSymbol `json:"symbol"`
+}
+
+type Market struct {
+	// The list of goods that are bought and sold between agents at this market.
+	Exchange []TradeGood `json:"exchange"`
+	// The list of goods that are exported from this market.
+	Exports []TradeGood `json:"exports"`
+	// The list of goods that are sought as imports in this market.
+	Imports []TradeGood `json:"imports"`
+	// The symbol of the market. The symbol is the same as the waypoint where the market is located.
+	Symbol string `json:"symbol"`
+	// The list of goods that are traded at this market. Visible only when a ship is present at the market.
+	TradeGoods []MarketTradeGood `json:"tradeGoods,omitempty"`
+	// The list of recent transactions at this market. Visible only when a ship is present at the market.
+	Transactions []MarketTransaction `json:"transactions,omitempty"`
+}
+
+type MarketTradeGood struct {
+	Activity ActivityLevel `json:"activity,omitempty"`
+	// The price at which this good can be purchased from the market.
+	PurchasePrice int `json:"purchasePrice"`
+	// The price at which this good can be sold to the market.
+	SellPrice int         `json:"sellPrice"`
+	Supply    SupplyLevel `json:"supply"`
+	Symbol    TradeSymbol `json:"symbol"`
+	// This is the maximum number of units that can be purchased or sold at this market in a single trade for this good. Trade volume also gives an indication of price volatility. A market with a low trade volume will have large price swings, while high trade volume will be more resilient to price changes.
+	TradeVolume int `json:"tradeVolume"`
+	// The type of trade good (export, import, or exchange).
+	Type TradeGoodType `json:"type"`
+}
+
+// Result of a transaction with a market.
+type MarketTransaction struct {
+	// The price per unit of the transaction.
+	PricePerUnit int `json:"pricePerUnit"`
+	// The symbol of the ship that made the transaction.
+	ShipSymbol string `json:"shipSymbol"`
+	// The timestamp of the transaction.
+	Timestamp string `json:"timestamp"`
+	// The total price of the transaction.
+	TotalPrice int `json:"totalPrice"`
+	// The symbol of the trade good.
+	TradeSymbol string `json:"tradeSymbol"`
+	// The type of transaction.
+	Type TransactionType `json:"type"`
+	// The number of units of the transaction.
+	Units          int            `json:"units"`
+	WaypointSymbol WaypointSymbol `json:"waypointSymbol"`
+}
+
+// Meta details for pagination.
+type Meta struct {
+	// The amount of items in each page. Limits how many items can be fetched at once.
+	Limit int `json:"limit"`
+	// A page denotes an amount of items, offset from the first item. Each page holds an amount of items equal to the `limit`.
+	Page int `json:"page"`
+	// Shows the total amount of items of this kind that exist.
+	Total int `json:"total"`
+}
+
+// Result of a repair transaction.
+type RepairTransaction struct {
+	// The symbol of the ship.
+	ShipSymbol string `json:"shipSymbol"`
+	// The timestamp of the transaction.
+	Timestamp string `json:"timestamp"`
+	// The total price of the transaction.
+	TotalPrice     int            `json:"totalPrice"`
+	WaypointSymbol WaypointSymbol `json:"waypointSymbol"`
+}
+
+// The ship that was scanned. Details include information about the ship that could be detected by the scanner.
+type ScannedShip struct {
+	// The engine of the ship.
+	Engine *ScannedShipEngine `json:"engine"`
+	// The frame of the ship.
+	Frame *ScannedShipFrame `json:"frame,omitempty"`
+	// List of mounts installed in the ship.
+	Mounts []ScannedShipMountsItem `json:"mounts,omitempty"`
+	Nav    *ShipNav                `json:"nav"`
+	// The reactor of the ship.
+	Reactor      *ScannedShipReactor `json:"reactor,omitempty"`
+	Registration *ShipRegistration   `json:"registration"`
+	// The globally unique identifier of the ship.
+	Symbol string `json:"symbol"`
+}
+
+// Details of a system was that scanned.
+type ScannedSystem struct {
+	// The system's distance from the scanning ship.
+	Distance int `json:"distance"`
+	// Symbol of the system's sector.
+	SectorSymbol string `json:"sectorSymbol"`
+	// Symbol of the system.
+	Symbol string     `json:"symbol"`
+	Type   SystemType `json:"type"`
+	// Position in the universe in the x axis.
+	X int `json:"x"`
+	// Position in the universe in the y axis.
+	Y int `json:"y"`
+}
+
+// A waypoint that was scanned by a ship.
+type ScannedWaypoint struct {
+	Chart   *Chart           `json:"chart,omitempty"`
+	Faction *WaypointFaction `json:"faction,omitempty"`
+	// List of waypoints that orbit this waypoint.
+	Orbitals     []WaypointOrbital `json:"orbitals"`
+	Symbol       WaypointSymbol    `json:"symbol"`
+	SystemSymbol SystemSymbol      `json:"systemSymbol"`
+	// The traits of the waypoint.
+	Traits []WaypointTrait `json:"traits"`
+	Type   WaypointType    `json:"type"`
+	// Position in the universe in the x axis.
+	X int `json:"x"`
+	// Position in the universe in the y axis.
+	Y int `json:"y"`
+}
+
+// Result of a scrap transaction.
+type ScrapTransaction struct {
+	// The symbol of the ship.
+	ShipSymbol string `json:"shipSymbol"`
+	// The timestamp of the transaction.
+	Timestamp string `json:"timestamp"`
+	// The total price of the transaction.
+	TotalPrice     int            `json:"totalPrice"`
+	WaypointSymbol WaypointSymbol `json:"waypointSymbol"`
+}
+
+// Ship details.
+type Ship struct {
+	Cargo    *ShipCargo  `json:"cargo"`
+	Cooldown *Cooldown   `json:"cooldown"`
+	Crew     *ShipCrew   `json:"crew"`
+	Engine   *ShipEngine `json:"engine"`
+	Frame    *ShipFrame  `json:"frame"`
+	Fuel     *ShipFuel   `json:"fuel"`
+	// Modules installed in this ship.
+	Modules []ShipModule `json:"modules"`
+	// Mounts installed in this ship.
+	Mounts       []ShipMount       `json:"mounts"`
+	Nav          *ShipNav          `json:"nav"`
+	Reactor      *ShipReactor      `json:"reactor"`
+	Registration *ShipRegistration `json:"registration"`
+	// The globally unique identifier of the ship in the following format: `[AGENT_SYMBOL]-[HEX_ID]`
+	Symbol string `json:"symbol"`
+}
+
+// Ship cargo details.
+type ShipCargo struct {
+	// The max number of items that can be stored in the cargo hold.
+	Capacity int `json:"capacity"`
+	// The items currently in the cargo hold.
+	Inventory []ShipCargoItem `json:"inventory"`
+	// The number of items currently stored in the cargo hold.
+	Units int `json:"units"`
+}
+
+// The type of cargo item and the number of units.
+type ShipCargoItem struct {
+	// The description of the cargo item type.
+	Description string `json:"description"`
+	// The name of the cargo item type.
+	Name   string      `json:"name"`
+	Symbol TradeSymbol `json:"symbol"`
+	// The number of units of the cargo item.
+	Units int `json:"units"`
+}
+
+// The repairable condition of a component. A value of 0 indicates the component needs significant repairs, while a value of 1 indicates the component is in near perfect condition. As the condition of a component is repaired, the overall integrity of the component decreases.
+type ShipComponentCondition = float64
+
+// The overall integrity of the component, which determines the performance of the component. A value of 0 indicates that the component is almost completely degraded, while a value of 1 indicates that the component is in near perfect condition. The integrity of the component is non-repairable, and represents permanent wear over time.
+type ShipComponentIntegrity = float64
+
+// An event that affects a ship component.
+type ShipConditionEvent struct {
+	Component ShipConditionEventComponent `json:"component"`
+	// A description of the event.
+	Description string `json:"description"`
+	// The name of the event.
+	Name   string                   `json:"name"`
+	Symbol ShipConditionEventSymbol `json:"symbol"`
+}
+
+// The ship's crew service and maintain the ship's systems and equipment.
+type ShipCrew struct {
+	// The maximum number of crew members the ship can support.
+	Capacity int `json:"capacity"`
+	// The current number of crew members on the ship.
+	Current int `json:"current"`
+	// A rough measure of the crew's morale. A higher morale means the crew is happier and more productive. A lower morale means the ship is more prone to accidents.
+	Morale int `json:"morale"`
+	// The minimum number of crew members required to maintain the ship.
+	Required int `json:"required"`
+	// The rotation of crew shifts. A stricter shift improves the ship's performance. A more relaxed shift improves the crew's morale.
+	Rotation ShipCrewRotation `json:"rotation"`
+	// The amount of credits per crew member paid per hour. Wages are paid when a ship docks at a civilized waypoint.
+	Wages int `json:"wages"`
+}
+
+// The engine determines how quickly a ship travels between waypoints.
+type ShipEngine struct {
+	Condition ShipComponentCondition `json:"condition"`
+	// The description of the engine.
+	Description string                 `json:"description"`
+	Integrity   ShipComponentIntegrity `json:"integrity"`
+	// The name of the engine.
+	Name         string            `json:"name"`
+	Requirements *ShipRequirements `json:"requirements"`
+	// The speed stat of this engine. The higher the speed, the faster a ship can travel from one point to another. Reduces the time of arrival when navigating the ship.
+	Speed int `json:"speed"`
+	// The symbol of the engine.
+	Symbol ShipEngineSymbol `json:"symbol"`
+}
+
+// The frame of the ship. The frame determines the number of modules and mounting points of the ship, as well as base fuel capacity. As the condition of the frame takes more wear, the ship will become more sluggish and less maneuverable.
+type ShipFrame struct {
+	Condition ShipComponentCondition `json:"condition"`
+	// Description of the frame.
+	Description string `json:"description"`
+	// The maximum amount of fuel that can be stored in this ship. When refueling, the ship will be refueled to this amount.
+	FuelCapacity int                    `json:"fuelCapacity"`
+	Integrity    ShipComponentIntegrity `json:"integrity"`
+	// The amount of slots that can be dedicated to modules installed in the ship. Each installed module take up a number of slots, and once there are no more slots, no new modules can be installed.
+	ModuleSlots int `json:"moduleSlots"`
+	// The amount of slots that can be dedicated to mounts installed in the ship. Each installed mount takes up a number of points, and once there are no more points remaining, no new mounts can be installed.
+	MountingPoints int `json:"mountingPoints"`
+	// Name of the frame.
+	Name         string            `json:"name"`
+	Requirements *ShipRequirements `json:"requirements"`
+	// Symbol of the frame.
+	Symbol ShipFrameSymbol `json:"symbol"`
+}
+
+// Details of the ship's fuel tanks including how much fuel was consumed during the last transit or action.
+type ShipFuel struct {
+	// The maximum amount of fuel the ship's tanks can hold.
+	Capacity int `json:"capacity"`
+	// An object that only shows up when an action has consumed fuel in the process. Shows the fuel consumption data.
+	Consumed *ShipFuelConsumed `json:"consumed,omitempty"`
+	// The current amount of fuel in the ship's tanks.
+	Current int `json:"current"`
+}
+
+// Result of a transaction for a ship modification, such as installing a mount or a module.
+type ShipModificationTransaction struct {
+	// The symbol of the ship that made the transaction.
+	ShipSymbol string `json:"shipSymbol"`
+	// The timestamp of the transaction.
+	Timestamp string `json:"timestamp"`
+	// The total price of the transaction.
+	TotalPrice int `json:"totalPrice"`
+	// The symbol of the trade good.
+	TradeSymbol string `json:"tradeSymbol"`
+	// The symbol of the waypoint where the transaction took place.
+	WaypointSymbol string `json:"waypointSymbol"`
+}
+
+// A module can be installed in a ship and provides a set of capabilities such as storage space or quarters for crew. Module installations are permanent.
+type ShipModule struct {
+	// Modules that provide capacity, such as cargo hold or crew quarters will show this value to denote how much of a bonus the module grants.
+	Capacity int `json:"capacity,omitempty"`
+	// Description of this module.
+	Description string `json:"description"`
+	// Name of this module.
+	Name string `json:"name"`
+	// Modules that have a range will such as a sensor array show this value to denote how far can the module reach with its capabilities.
+	Range        int               `json:"range,omitempty"`
+	Requirements *ShipRequirements `json:"requirements"`
+	// The symbol of the module.
+	Symbol ShipModuleSymbol `json:"symbol"`
+}
+
+// A mount is installed on the exterier of a ship.
+type ShipMount struct {
+	// Mounts that have this value denote what goods can be produced from using the mount.
+	Deposits []TradeSymbol `json:"deposits,omitempty"`
+	// Description of this mount.
+	Description string `json:"description,omitempty"`
+	// Name of this mount.
+	Name         string            `json:"name"`
+	Requirements *ShipRequirements `json:"requirements"`
+	// Mounts that have this value, such as mining lasers, denote how powerful this mount's capabilities are.
+	Strength int `json:"strength,omitempty"`
+	// Symbol of this mount.
+	Symbol ShipMountSymbol `json:"symbol"`
+}
+
+// The navigation information of the ship.
+type ShipNav struct {
+	FlightMode     ShipNavFlightMode `json:"flightMode"`
+	Route          *ShipNavRoute     `json:"route"`
+	Status         ShipNavStatus     `json:"status"`
+	SystemSymbol   SystemSymbol      `json:"systemSymbol"`
+	WaypointSymbol WaypointSymbol    `json:"waypointSymbol"`
+}
+
+// The ship's set speed when traveling between waypoints or systems.
+type ShipNavFlightMode string
+
+const (
+	FLIGHT_MODE_DRIFT   ShipNavFlightMode = "DRIFT"
+	FLIGHT_MODE_STEALTH ShipNavFlightMode = "STEALTH"
+	FLIGHT_MODE_CRUISE  ShipNavFlightMode = "CRUISE"
+	FLIGHT_MODE_BURN    ShipNavFlightMode = "BURN"
+)
+
+var knownShipNavFlightModes = []ShipNavFlightMode{
+	FLIGHT_MODE_DRIFT,
+	FLIGHT_MODE_STEALTH,
+	FLIGHT_MODE_CRUISE,
+	FLIGHT_MODE_BURN,
+}
+
+// Every ShipNavFlightMode this package knows about.
+func AllShipNavFlightModes() []ShipNavFlightMode {
+	return append([]ShipNavFlightMode(nil), knownShipNavFlightModes...)
+}
+
+func (self ShipNavFlightMode) IsKnown() bool {
+	for _, value := range knownShipNavFlightModes {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self ShipNavFlightMode) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a ShipNavFlightMode.", UnknownEnumError, string(self))
+}
+
+// The routing information for the ship's most recent transit or current location.
+type ShipNavRoute struct {
+	// The date time of the ship's arrival. If the ship is in-transit, this is the expected time of arrival.
+	Arrival string `json:"arrival"`
+	// The date time of the ship's departure.
+	DepartureTime string                `json:"departureTime"`
+	Destination   *ShipNavRouteWaypoint `json:"destination"`
+	Origin        *ShipNavRouteWaypoint `json:"origin"`
+}
+
+// The destination or departure of a ships nav route.
+type ShipNavRouteWaypoint struct {
+	// The symbol of the waypoint.
+	Symbol       string       `json:"symbol"`
+	SystemSymbol SystemSymbol `json:"systemSymbol"`
+	Type         WaypointType `json:"type"`
+	// Position in the universe in the x axis.
+	X int `json:"x"`
+	// Position in the universe in the y axis.
+	Y int `json:"y"`
+}
+
+// The current status of the ship
+type ShipNavStatus string
+
+const (
+	NAV_STATUS_IN_TRANSIT ShipNavStatus = "IN_TRANSIT"
+	NAV_STATUS_IN_ORBIT   ShipNavStatus = "IN_ORBIT"
+	NAV_STATUS_DOCKED     ShipNavStatus = "DOCKED"
+)
+
+var knownShipNavStatuses = []ShipNavStatus{
+	NAV_STATUS_IN_TRANSIT,
+	NAV_STATUS_IN_ORBIT,
+	NAV_STATUS_DOCKED,
+}
+
+// Every ShipNavStatus this package knows about.
+func AllShipNavStatuses() []ShipNavStatus {
+	return append([]ShipNavStatus(nil), knownShipNavStatuses...)
+}
+
+func (self ShipNavStatus) IsKnown() bool {
+	for _, value := range knownShipNavStatuses {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self ShipNavStatus) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a ShipNavStatus.", UnknownEnumError, string(self))
+}
+
+// The reactor of the ship. The reactor is responsible for powering the ship's systems and weapons.
+type ShipReactor struct {
+	Condition ShipComponentCondition `json:"condition"`
+	// Description of the reactor.
+	Description string                 `json:"description"`
+	Integrity   ShipComponentIntegrity `json:"integrity"`
+	// Name of the reactor.
+	Name string `json:"name"`
+	// The amount of power provided by this reactor. The more power a reactor provides to the ship, the lower the cooldown it gets when using a module or mount that taxes the ship's power.
+	PowerOutput  int               `json:"powerOutput"`
+	Requirements *ShipRequirements `json:"requirements"`
+	// Symbol of the reactor.
+	Symbol ShipReactorSymbol `json:"symbol"`
+}
+
+// The public registration information of the ship
+type ShipRegistration struct {
+	// The symbol of the faction the ship is registered with
+	FactionSymbol string `json:"factionSymbol,omitempty"`
+	// The agent's registered name of the ship
+	Name string   `json:"name"`
+	Role ShipRole `json:"role"`
+}
+
+// The requirements for installation on a ship
+type ShipRequirements struct {
+	// The number of crew required for operation.
+	Crew int `json:"crew,omitempty"`
+	// The amount of power required from the reactor.
+	Power int `json:"power,omitempty"`
+	// The number of module slots required for installation.
+	Slots int `json:"slots,omitempty"`
+}
+
+// The registered role of the ship
+type ShipRole string
+
+const (
+	SHIP_ROLE_FABRICATOR  ShipRole = "FABRICATOR"
+	SHIP_ROLE_HARVESTER   ShipRole = "HARVESTER"
+	SHIP_ROLE_HAULER      ShipRole = "HAULER"
+	SHIP_ROLE_INTERCEPTOR ShipRole = "INTERCEPTOR"
+	SHIP_ROLE_EXCAVATOR   ShipRole = "EXCAVATOR"
+	SHIP_ROLE_TRANSPORT   ShipRole = "TRANSPORT"
+	SHIP_ROLE_REPAIR      ShipRole = "REPAIR"
+	SHIP_ROLE_SURVEYOR    ShipRole = "SURVEYOR"
+	SHIP_ROLE_COMMAND     ShipRole = "COMMAND"
+	SHIP_ROLE_CARRIER     ShipRole = "CARRIER"
+	SHIP_ROLE_PATROL      ShipRole = "PATROL"
+	SHIP_ROLE_SATELLITE   ShipRole = "SATELLITE"
+	SHIP_ROLE_EXPLORER    ShipRole = "EXPLORER"
+	SHIP_ROLE_REFINERY    ShipRole = "REFINERY"
+)
+
+var knownShipRoles = []ShipRole{
+	SHIP_ROLE_FABRICATOR,
+	SHIP_ROLE_HARVESTER,
+	SHIP_ROLE_HAULER,
+	SHIP_ROLE_INTERCEPTOR,
+	SHIP_ROLE_EXCAVATOR,
+	SHIP_ROLE_TRANSPORT,
+	SHIP_ROLE_REPAIR,
+	SHIP_ROLE_SURVEYOR,
+	SHIP_ROLE_COMMAND,
+	SHIP_ROLE_CARRIER,
+	SHIP_ROLE_PATROL,
+	SHIP_ROLE_SATELLITE,
+	SHIP_ROLE_EXPLORER,
+	SHIP_ROLE_REFINERY,
+}
+
+// Every ShipRole this package knows about.
+func AllShipRoles() []ShipRole {
+	return append([]ShipRole(nil), knownShipRoles...)
+}
+
+func (self ShipRole) IsKnown() bool {
+	for _, value := range knownShipRoles {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self ShipRole) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a ShipRole.", UnknownEnumError, string(self))
+}
+
+// Type of ship
+type ShipType string
+
+const (
+	SHIP_PROBE              ShipType = "SHIP_PROBE"
+	SHIP_MINING_DRONE       ShipType = "SHIP_MINING_DRONE"
+	SHIP_SIPHON_DRONE       ShipType = "SHIP_SIPHON_DRONE"
+	SHIP_INTERCEPTOR        ShipType = "SHIP_INTERCEPTOR"
+	SHIP_LIGHT_HAULER       ShipType = "SHIP_LIGHT_HAULER"
+	SHIP_COMMAND_FRIGATE    ShipType = "SHIP_COMMAND_FRIGATE"
+	SHIP_EXPLORER           ShipType = "SHIP_EXPLORER"
+	SHIP_HEAVY_FREIGHTER    ShipType = "SHIP_HEAVY_FREIGHTER"
+	SHIP_LIGHT_SHUTTLE      ShipType = "SHIP_LIGHT_SHUTTLE"
+	SHIP_ORE_HOUND          ShipType = "SHIP_ORE_HOUND"
+	SHIP_REFINING_FREIGHTER ShipType = "SHIP_REFINING_FREIGHTER"
+	SHIP_SURVEYOR           ShipType = "SHIP_SURVEYOR"
+)
+
+var knownShipTypes = []ShipType{
+	SHIP_PROBE,
+	SHIP_MINING_DRONE,
+	SHIP_SIPHON_DRONE,
+	SHIP_INTERCEPTOR,
+	SHIP_LIGHT_HAULER,
+	SHIP_COMMAND_FRIGATE,
+	SHIP_EXPLORER,
+	SHIP_HEAVY_FREIGHTER,
+	SHIP_LIGHT_SHUTTLE,
+	SHIP_ORE_HOUND,
+	SHIP_REFINING_FREIGHTER,
+	SHIP_SURVEYOR,
+}
+
+// Every ShipType this package knows about.
+func AllShipTypes() []ShipType {
+	return append([]ShipType(nil), knownShipTypes...)
+}
+
+func (self ShipType) IsKnown() bool {
+	for _, value := range knownShipTypes {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self ShipType) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a ShipType.", UnknownEnumError, string(self))
+}
+
+// Shipyard details.
+type Shipyard struct {
+	// The fee to modify a ship at this shipyard. This includes installing or removing modules and mounts on a ship. In the case of mounts, the fee is a flat rate per mount. In the case of modules, the fee is per slot the module occupies.
+	ModificationsFee int `json:"modificationsFee"`
+	// The list of ship types available for purchase at this shipyard.
+	ShipTypes []ShipyardShipTypesItem `json:"shipTypes"`
+	// The ships that are currently available for purchase at the shipyard.
+	Ships []ShipyardShip `json:"ships,omitempty"`
+	// The symbol of the shipyard. The symbol is the same as the waypoint where the shipyard is located.
+	Symbol string `json:"symbol"`
+	// The list of recent transactions at this shipyard.
+	Transactions []ShipyardTransaction `json:"transactions,omitempty"`
+}
+
+// Ship details available at a shipyard.
+type ShipyardShip struct {
+	Activity      ActivityLevel     `json:"activity,omitempty"`
+	Crew          *ShipyardShipCrew `json:"crew"`
+	Description   string            `json:"description"`
+	Engine        *ShipEngine       `json:"engine"`
+	Frame         *ShipFrame        `json:"frame"`
+	Modules       []ShipModule      `json:"modules"`
+	Mounts        []ShipMount       `json:"mounts"`
+	Name          string            `json:"name"`
+	PurchasePrice int               `json:"purchasePrice"`
+	Reactor       *ShipReactor      `json:"reactor"`
+	Supply        SupplyLevel       `json:"supply"`
+	Type          ShipType          `json:"type"`
+}
+
+// Results of a transaction with a shipyard.
+type ShipyardTransaction struct {
+	// The symbol of the agent that made the transaction.
+	AgentSymbol string `json:"agentSymbol"`
+	// The price of the transaction.
+	Price int `json:"price"`
+	// The symbol of the ship type (e.g. SHIP_MINING_DRONE) that was the subject of the transaction. Contrary to what the name implies, this is NOT the symbol of the ship that was purchased.
+	ShipSymbol string `json:"shipSymbol"`
+	// The symbol of the ship type (e.g. SHIP_MINING_DRONE) that was the subject of the transaction.
+	ShipType string `json:"shipType"`
+	// The timestamp of the transaction.
+	Timestamp      string         `json:"timestamp"`
+	WaypointSymbol WaypointSymbol `json:"waypointSymbol"`
+}
+
+// Siphon details.
+type Siphon struct {
+	// Symbol of the ship that executed the siphon.
+	ShipSymbol string       `json:"shipSymbol"`
+	Yield      *SiphonYield `json:"yield"`
+}
+
+// A yield from the siphon operation.
+type SiphonYield struct {
+	Symbol TradeSymbol `json:"symbol"`
+	// The number of units siphoned that were placed into the ship's cargo hold.
+	Units int `json:"units"`
+}
+
+// The supply level of a trade good.
+type SupplyLevel string
+
+const (
+	SUPPLY_SCARCE   SupplyLevel = "SCARCE"
+	SUPPLY_LIMITED  SupplyLevel = "LIMITED"
+	SUPPLY_MODERATE SupplyLevel = "MODERATE"
+	SUPPLY_HIGH     SupplyLevel = "HIGH"
+	SUPPLY_ABUNDANT SupplyLevel = "ABUNDANT"
+)
+
+var knownSupplyLevels = []SupplyLevel{
+	SUPPLY_SCARCE,
+	SUPPLY_LIMITED,
+	SUPPLY_MODERATE,
+	SUPPLY_HIGH,
+	SUPPLY_ABUNDANT,
+}
+
+// Every SupplyLevel this package knows about.
+func AllSupplyLevels() []SupplyLevel {
+	return append([]SupplyLevel(nil), knownSupplyLevels...)
+}
+
+func (self SupplyLevel) IsKnown() bool {
+	for _, value := range knownSupplyLevels {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self SupplyLevel) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a SupplyLevel.", UnknownEnumError, string(self))
+}
+
+// A resource survey of a waypoint, detailing a specific extraction location and the types of resources that can be found there.
+type Survey struct {
+	// A list of deposits that can be found at this location. A ship will extract one of these deposits when using this survey in an extraction request. If multiple deposits of the same type are present, the chance of extracting that deposit is increased.
+	Deposits []SurveyDeposit `json:"deposits"`
+	// The date and time when the survey expires. After this date and time, the survey will no longer be available for extraction.
+	Expiration string `json:"expiration"`
+	// A unique signature for the location of this survey. This signature is verified when attempting an extraction using this survey.
+	Signature string `json:"signature"`
+	// The size of the deposit. This value indicates how much can be extracted from the survey before it is exhausted.
+	Size SurveySize `json:"size"`
+	// The symbol of the waypoint that this survey is for.
+	Symbol string `json:"symbol"`
+}
+
+// A surveyed deposit of a mineral or resource available for extraction.
+type SurveyDeposit struct {
+	// The symbol of the deposit.
+	Symbol string `json:"symbol"`
+}
+
+type System struct {
+	// Factions that control this system.
+	Factions []SystemFaction `json:"factions"`
+	// The name of the system.
+	Name string `json:"name,omitempty"`
+	// The symbol of the sector.
+	SectorSymbol string `json:"sectorSymbol"`
+	// The symbol of the system.
+	Symbol string     `json:"symbol"`
+	Type   SystemType `json:"type"`
+	// Waypoints in this system.
+	Waypoints []SystemWaypoint `json:"waypoints"`
+	// Relative position of the system in the sector in the x axis.
+	X int `json:"x"`
+	// Relative position of the system in the sector in the y axis.
+	Y int `json:"y"`
+}
+
+type SystemFaction struct {
+	Symbol FactionSymbol `json:"symbol"`
+}
+
+// The symbol of the system.
+type SystemSymbol = string
+
+// The type of system.
+type SystemType string
+
+const (
+	SYSTEM_TYPE_NEUTRON_STAR SystemType = "NEUTRON_STAR"
+	SYSTEM_TYPE_RED_STAR     SystemType = "RED_STAR"
+	SYSTEM_TYPE_ORANGE_STAR  SystemType = "ORANGE_STAR"
+	SYSTEM_TYPE_BLUE_STAR    SystemType = "BLUE_STAR"
+	SYSTEM_TYPE_YOUNG_STAR   SystemType = "YOUNG_STAR"
+	SYSTEM_TYPE_WHITE_DWARF  SystemType = "WHITE_DWARF"
+	SYSTEM_TYPE_BLACK_HOLE   SystemType = "BLACK_HOLE"
+	SYSTEM_TYPE_HYPERGIANT   SystemType = "HYPERGIANT"
+	SYSTEM_TYPE_NEBULA       SystemType = "NEBULA"
+	SYSTEM_TYPE_UNSTABLE     SystemType = "UNSTABLE"
+)
+
+var knownSystemTypes = []SystemType{
+	SYSTEM_TYPE_NEUTRON_STAR,
+	SYSTEM_TYPE_RED_STAR,
+	SYSTEM_TYPE_ORANGE_STAR,
+	SYSTEM_TYPE_BLUE_STAR,
+	SYSTEM_TYPE_YOUNG_STAR,
+	SYSTEM_TYPE_WHITE_DWARF,
+	SYSTEM_TYPE_BLACK_HOLE,
+	SYSTEM_TYPE_HYPERGIANT,
+	SYSTEM_TYPE_NEBULA,
+	SYSTEM_TYPE_UNSTABLE,
+}
+
+// Every SystemType this package knows about.
+func AllSystemTypes() []SystemType {
+	return append([]SystemType(nil), knownSystemTypes...)
+}
+
+func (self SystemType) IsKnown() bool {
+	for _, value := range knownSystemTypes {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self SystemType) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a SystemType.", UnknownEnumError, string(self))
+}
+
+type SystemWaypoint struct {
+	// Waypoints that orbit this waypoint.
+	Orbitals []WaypointOrbital `json:"orbitals"`
+	// The symbol of the parent waypoint, if this waypoint is in orbit around another waypoint. Otherwise this value is undefined.
+	Orbits string         `json:"orbits,omitempty"`
+	Symbol WaypointSymbol `json:"symbol"`
+	Type   WaypointType   `json:"type"`
+	// Relative position of the waypoint on the system's x axis. This is not an absolute position in the universe.
+	X int `json:"x"`
+	// Relative position of the waypoint on the system's y axis. This is not an absolute position in the universe.
+	Y int `json:"y"`
+}
+
+// A good that can be traded for other goods or currency.
+type TradeGood struct {
+	// The description of the good.
+	Description string `json:"description"`
+	// The name of the good.
+	Name   string      `json:"name"`
+	Symbol TradeSymbol `json:"symbol"`
+}
+
+// The good's symbol.
+type TradeSymbol string
+
+const (
+	TRADE_SYMBOL_PRECIOUS_STONES            TradeSymbol = "PRECIOUS_STONES"
+	TRADE_SYMBOL_QUARTZ_SAND                TradeSymbol = "QUARTZ_SAND"
+	TRADE_SYMBOL_SILICON_CRYSTALS           TradeSymbol = "SILICON_CRYSTALS"
+	TRADE_SYMBOL_AMMONIA_ICE                TradeSymbol = "AMMONIA_ICE"
+	TRADE_SYMBOL_LIQUID_HYDROGEN            TradeSymbol = "LIQUID_HYDROGEN"
+	TRADE_SYMBOL_LIQUID_NITROGEN            TradeSymbol = "LIQUID_NITROGEN"
+	TRADE_SYMBOL_ICE_WATER                  TradeSymbol = "ICE_WATER"
+	TRADE_SYMBOL_EXOTIC_MATTER              TradeSymbol = "EXOTIC_MATTER"
+	TRADE_SYMBOL_ADVANCED_CIRCUITRY         TradeSymbol = "ADVANCED_CIRCUITRY"
+	TRADE_SYMBOL_GRAVITON_EMITTERS          TradeSymbol = "GRAVITON_EMITTERS"
+	TRADE_SYMBOL_IRON                       TradeSymbol = "IRON"
+	TRADE_SYMBOL_IRON_ORE                   TradeSymbol = "IRON_ORE"
+	TRADE_SYMBOL_COPPER                     TradeSymbol = "COPPER"
+	TRADE_SYMBOL_COPPER_ORE                 TradeSymbol = "COPPER_ORE"
+	TRADE_SYMBOL_ALUMINUM                   TradeSymbol = "ALUMINUM"
+	TRADE_SYMBOL_ALUMINUM_ORE               TradeSymbol = "ALUMINUM_ORE"
+	TRADE_SYMBOL_SILVER                     TradeSymbol = "SILVER"
+	TRADE_SYMBOL_SILVER_ORE                 TradeSymbol = "SILVER_ORE"
+	TRADE_SYMBOL_GOLD                       TradeSymbol = "GOLD"
+	TRADE_SYMBOL_GOLD_ORE                   TradeSymbol = "GOLD_ORE"
+	TRADE_SYMBOL_PLATINUM                   TradeSymbol = "PLATINUM"
+	TRADE_SYMBOL_PLATINUM_ORE               TradeSymbol = "PLATINUM_ORE"
+	TRADE_SYMBOL_DIAMONDS                   TradeSymbol = "DIAMONDS"
+	TRADE_SYMBOL_URANITE                    TradeSymbol = "URANITE"
+	TRADE_SYMBOL_URANITE_ORE                TradeSymbol = "URANITE_ORE"
+	TRADE_SYMBOL_MERITIUM                   TradeSymbol = "MERITIUM"
+	TRADE_SYMBOL_MERITIUM_ORE               TradeSymbol = "MERITIUM_ORE"
+	TRADE_SYMBOL_HYDROCARBON                TradeSymbol = "HYDROCARBON"
+	TRADE_SYMBOL_ANTIMATTER                 TradeSymbol = "ANTIMATTER"
+	TRADE_SYMBOL_FAB_MATS                   TradeSymbol = "FAB_MATS"
+	TRADE_SYMBOL_FERTILIZERS                TradeSymbol = "FERTILIZERS"
+	TRADE_SYMBOL_FABRICS                    TradeSymbol = "FABRICS"
+	TRADE_SYMBOL_FOOD                       TradeSymbol = "FOOD"
+	TRADE_SYMBOL_JEWELRY                    TradeSymbol = "JEWELRY"
+	TRADE_SYMBOL_MACHINERY                  TradeSymbol = "MACHINERY"
+	TRADE_SYMBOL_FIREARMS                   TradeSymbol = "FIREARMS"
+	TRADE_SYMBOL_ASSAULT_RIFLES             TradeSymbol = "ASSAULT_RIFLES"
+	TRADE_SYMBOL_MILITARY_EQUIPMENT         TradeSymbol = "MILITARY_EQUIPMENT"
+	TRADE_SYMBOL_EXPLOSIVES                 TradeSymbol = "EXPLOSIVES"
+	TRADE_SYMBOL_LAB_INSTRUMENTS            TradeSymbol = "LAB_INSTRUMENTS"
+	TRADE_SYMBOL_AMMUNITION                 TradeSymbol = "AMMUNITION"
+	TRADE_SYMBOL_ELECTRONICS                TradeSymbol = "ELECTRONICS"
+	TRADE_SYMBOL_SHIP_PLATING               TradeSymbol = "SHIP_PLATING"
+	TRADE_SYMBOL_SHIP_PARTS                 TradeSymbol = "SHIP_PARTS"
+	TRADE_SYMBOL_EQUIPMENT                  TradeSymbol = "EQUIPMENT"
+	TRADE_SYMBOL_FUEL                       TradeSymbol = "FUEL"
+	TRADE_SYMBOL_MEDICINE                   TradeSymbol = "MEDICINE"
+	TRADE_SYMBOL_DRUGS                      TradeSymbol = "DRUGS"
+	TRADE_SYMBOL_CLOTHING                   TradeSymbol = "CLOTHING"
+	TRADE_SYMBOL_MICROPROCESSORS            TradeSymbol = "MICROPROCESSORS"
+	TRADE_SYMBOL_PLASTICS                   TradeSymbol = "PLASTICS"
+	TRADE_SYMBOL_POLYNUCLEOTIDES            TradeSymbol = "POLYNUCLEOTIDES"
+	TRADE_SYMBOL_BIOCOMPOSITES              TradeSymbol = "BIOCOMPOSITES"
+	TRADE_SYMBOL_QUANTUM_STABILIZERS        TradeSymbol = "QUANTUM_STABILIZERS"
+	TRADE_SYMBOL_NANOBOTS                   TradeSymbol = "NANOBOTS"
+	TRADE_SYMBOL_AI_MAINFRAMES              TradeSymbol = "AI_MAINFRAMES"
+	TRADE_SYMBOL_QUANTUM_DRIVES             TradeSymbol = "QUANTUM_DRIVES"
+	TRADE_SYMBOL_ROBOTIC_DRONES             TradeSymbol = "ROBOTIC_DRONES"
+	TRADE_SYMBOL_CYBER_IMPLANTS             TradeSymbol = "CYBER_IMPLANTS"
+	TRADE_SYMBOL_GENE_THERAPEUTICS          TradeSymbol = "GENE_THERAPEUTICS"
+	TRADE_SYMBOL_NEURAL_CHIPS               TradeSymbol = "NEURAL_CHIPS"
+	TRADE_SYMBOL_MOOD_REGULATORS            TradeSymbol = "MOOD_REGULATORS"
+	TRADE_SYMBOL_VIRAL_AGENTS               TradeSymbol = "VIRAL_AGENTS"
+	TRADE_SYMBOL_MICRO_FUSION_GENERATORS    TradeSymbol = "MICRO_FUSION_GENERATORS"
+	TRADE_SYMBOL_SUPERGRAINS                TradeSymbol = "SUPERGRAINS"
+	TRADE_SYMBOL_LASER_RIFLES               TradeSymbol = "LASER_RIFLES"
+	TRADE_SYMBOL_HOLOGRAPHICS               TradeSymbol = "HOLOGRAPHICS"
+	TRADE_SYMBOL_SHIP_SALVAGE               TradeSymbol = "SHIP_SALVAGE"
+	TRADE_SYMBOL_RELIC_TECH                 TradeSymbol = "RELIC_TECH"
+	TRADE_SYMBOL_NOVEL_LIFEFORMS            TradeSymbol = "NOVEL_LIFEFORMS"
+	TRADE_SYMBOL_BOTANICAL_SPECIMENS        TradeSymbol = "BOTANICAL_SPECIMENS"
+	TRADE_SYMBOL_CULTURAL_ARTIFACTS         TradeSymbol = "CULTURAL_ARTIFACTS"
+	TRADE_SYMBOL_FRAME_PROBE                TradeSymbol = "FRAME_PROBE"
+	TRADE_SYMBOL_FRAME_DRONE                TradeSymbol = "FRAME_DRONE"
+	TRADE_SYMBOL_FRAME_INTERCEPTOR          TradeSymbol = "FRAME_INTERCEPTOR"
+	TRADE_SYMBOL_FRAME_RACER                TradeSymbol = "FRAME_RACER"
+	TRADE_SYMBOL_FRAME_FIGHTER              TradeSymbol = "FRAME_FIGHTER"
+	TRADE_SYMBOL_FRAME_FRIGATE              TradeSymbol = "FRAME_FRIGATE"
+	TRADE_SYMBOL_FRAME_SHUTTLE              TradeSymbol = "FRAME_SHUTTLE"
+	TRADE_SYMBOL_FRAME_EXPLORER             TradeSymbol = "FRAME_EXPLORER"
+	TRADE_SYMBOL_FRAME_MINER                TradeSymbol = "FRAME_MINER"
+	TRADE_SYMBOL_FRAME_LIGHT_FREIGHTER      TradeSymbol = "FRAME_LIGHT_FREIGHTER"
+	TRADE_SYMBOL_FRAME_HEAVY_FREIGHTER      TradeSymbol = "FRAME_HEAVY_FREIGHTER"
+	TRADE_SYMBOL_FRAME_TRANSPORT            TradeSymbol = "FRAME_TRANSPORT"
+	TRADE_SYMBOL_FRAME_DESTROYER            TradeSymbol = "FRAME_DESTROYER"
+	TRADE_SYMBOL_FRAME_CRUISER              TradeSymbol = "FRAME_CRUISER"
+	TRADE_SYMBOL_FRAME_CARRIER              TradeSymbol = "FRAME_CARRIER"
+	TRADE_SYMBOL_REACTOR_SOLAR_I            TradeSymbol = "REACTOR_SOLAR_I"
+	TRADE_SYMBOL_REACTOR_FUSION_I           TradeSymbol = "REACTOR_FUSION_I"
+	TRADE_SYMBOL_REACTOR_FISSION_I          TradeSymbol = "REACTOR_FISSION_I"
+	TRADE_SYMBOL_REACTOR_CHEMICAL_I         TradeSymbol = "REACTOR_CHEMICAL_I"
+	TRADE_SYMBOL_REACTOR_ANTIMATTER_I       TradeSymbol = "REACTOR_ANTIMATTER_I"
+	TRADE_SYMBOL_ENGINE_IMPULSE_DRIVE_I     TradeSymbol = "ENGINE_IMPULSE_DRIVE_I"
+	TRADE_SYMBOL_ENGINE_ION_DRIVE_I         TradeSymbol = "ENGINE_ION_DRIVE_I"
+	TRADE_SYMBOL_ENGINE_ION_DRIVE_II        TradeSymbol = "ENGINE_ION_DRIVE_II"
+	TRADE_SYMBOL_ENGINE_HYPER_DRIVE_I       TradeSymbol = "ENGINE_HYPER_DRIVE_I"
+	TRADE_SYMBOL_MODULE_MINERAL_PROCESSOR_I TradeSymbol = "MODULE_MINERAL_PROCESSOR_I"
+	TRADE_SYMBOL_MODULE_GAS_PROCESSOR_I     TradeSymbol = "MODULE_GAS_PROCESSOR_I"
+	TRADE_SYMBOL_MODULE_CARGO_HOLD_I        TradeSymbol = "MODULE_CARGO_HOLD_I"
+	TRADE_SYMBOL_MODULE_CARGO_HOLD_II       TradeSymbol = "MODULE_CARGO_HOLD_II"
+	TRADE_SYMBOL_MODULE_CARGO_HOLD_III      TradeSymbol = "MODULE_CARGO_HOLD_III"
+	TRADE_SYMBOL_MODULE_CREW_QUARTERS_I     TradeSymbol = "MODULE_CREW_QUARTERS_I"
+	TRADE_SYMBOL_MODULE_ENVOY_QUARTERS_I    TradeSymbol = "MODULE_ENVOY_QUARTERS_I"
+	TRADE_SYMBOL_MODULE_PASSENGER_CABIN_I   TradeSymbol = "MODULE_PASSENGER_CABIN_I"
+	TRADE_SYMBOL_MODULE_MICRO_REFINERY_I    TradeSymbol = "MODULE_MICRO_REFINERY_I"
+	TRADE_SYMBOL_MODULE_SCIENCE_LAB_I       TradeSymbol = "MODULE_SCIENCE_LAB_I"
+	TRADE_SYMBOL_MODULE_JUMP_DRIVE_I        TradeSymbol = "MODULE_JUMP_DRIVE_I"
+	TRADE_SYMBOL_MODULE_JUMP_DRIVE_II       TradeSymbol = "MODULE_JUMP_DRIVE_II"
+	TRADE_SYMBOL_MODULE_JUMP_DRIVE_III      TradeSymbol = "MODULE_JUMP_DRIVE_III"
+	TRADE_SYMBOL_MODULE_WARP_DRIVE_I        TradeSymbol = "MODULE_WARP_DRIVE_I"
+	TRADE_SYMBOL_MODULE_WARP_DRIVE_II       TradeSymbol = "MODULE_WARP_DRIVE_II"
+	TRADE_SYMBOL_MODULE_WARP_DRIVE_III      TradeSymbol = "MODULE_WARP_DRIVE_III"
+	TRADE_SYMBOL_MODULE_SHIELD_GENERATOR_I  TradeSymbol = "MODULE_SHIELD_GENERATOR_I"
+	TRADE_SYMBOL_MODULE_SHIELD_GENERATOR_II TradeSymbol = "MODULE_SHIELD_GENERATOR_II"
+	TRADE_SYMBOL_MODULE_ORE_REFINERY_I      TradeSymbol = "MODULE_ORE_REFINERY_I"
+	TRADE_SYMBOL_MODULE_FUEL_REFINERY_I     TradeSymbol = "MODULE_FUEL_REFINERY_I"
+	TRADE_SYMBOL_MOUNT_GAS_SIPHON_I         TradeSymbol = "MOUNT_GAS_SIPHON_I"
+	TRADE_SYMBOL_MOUNT_GAS_SIPHON_II        TradeSymbol = "MOUNT_GAS_SIPHON_II"
+	TRADE_SYMBOL_MOUNT_GAS_SIPHON_III       TradeSymbol = "MOUNT_GAS_SIPHON_III"
+	TRADE_SYMBOL_MOUNT_SURVEYOR_I           TradeSymbol = "MOUNT_SURVEYOR_I"
+	TRADE_SYMBOL_MOUNT_SURVEYOR_II          TradeSymbol = "MOUNT_SURVEYOR_II"
+	TRADE_SYMBOL_MOUNT_SURVEYOR_III         TradeSymbol = "MOUNT_SURVEYOR_III"
+	TRADE_SYMBOL_MOUNT_SENSOR_ARRAY_I       TradeSymbol = "MOUNT_SENSOR_ARRAY_I"
+	TRADE_SYMBOL_MOUNT_SENSOR_ARRAY_II      TradeSymbol = "MOUNT_SENSOR_ARRAY_II"
+	TRADE_SYMBOL_MOUNT_SENSOR_ARRAY_III     TradeSymbol = "MOUNT_SENSOR_ARRAY_III"
+	TRADE_SYMBOL_MOUNT_MINING_LASER_I       TradeSymbol = "MOUNT_MINING_LASER_I"
+	TRADE_SYMBOL_MOUNT_MINING_LASER_II      TradeSymbol = "MOUNT_MINING_LASER_II"
+	TRADE_SYMBOL_MOUNT_MINING_LASER_III     TradeSymbol = "MOUNT_MINING_LASER_III"
+	TRADE_SYMBOL_MOUNT_LASER_CANNON_I       TradeSymbol = "MOUNT_LASER_CANNON_I"
+	TRADE_SYMBOL_MOUNT_MISSILE_LAUNCHER_I   TradeSymbol = "MOUNT_MISSILE_LAUNCHER_I"
+	TRADE_SYMBOL_MOUNT_TURRET_I             TradeSymbol = "MOUNT_TURRET_I"
+	TRADE_SYMBOL_SHIP_PROBE                 TradeSymbol = "SHIP_PROBE"
+	TRADE_SYMBOL_SHIP_MINING_DRONE          TradeSymbol = "SHIP_MINING_DRONE"
+	TRADE_SYMBOL_SHIP_SIPHON_DRONE          TradeSymbol = "SHIP_SIPHON_DRONE"
+	TRADE_SYMBOL_SHIP_INTERCEPTOR           TradeSymbol = "SHIP_INTERCEPTOR"
+	TRADE_SYMBOL_SHIP_LIGHT_HAULER          TradeSymbol = "SHIP_LIGHT_HAULER"
+	TRADE_SYMBOL_SHIP_COMMAND_FRIGATE       TradeSymbol = "SHIP_COMMAND_FRIGATE"
+	TRADE_SYMBOL_SHIP_EXPLORER              TradeSymbol = "SHIP_EXPLORER"
+	TRADE_SYMBOL_SHIP_HEAVY_FREIGHTER       TradeSymbol = "SHIP_HEAVY_FREIGHTER"
+	TRADE_SYMBOL_SHIP_LIGHT_SHUTTLE         TradeSymbol = "SHIP_LIGHT_SHUTTLE"
+	TRADE_SYMBOL_SHIP_ORE_HOUND             TradeSymbol = "SHIP_ORE_HOUND"
+	TRADE_SYMBOL_SHIP_REFINING_FREIGHTER    TradeSymbol = "SHIP_REFINING_FREIGHTER"
+	TRADE_SYMBOL_SHIP_SURVEYOR              TradeSymbol = "SHIP_SURVEYOR"
+)
+
+var knownTradeSymbols = []TradeSymbol{
+	TRADE_SYMBOL_PRECIOUS_STONES,
+	TRADE_SYMBOL_QUARTZ_SAND,
+	TRADE_SYMBOL_SILICON_CRYSTALS,
+	TRADE_SYMBOL_AMMONIA_ICE,
+	TRADE_SYMBOL_LIQUID_HYDROGEN,
+	TRADE_SYMBOL_LIQUID_NITROGEN,
+	TRADE_SYMBOL_ICE_WATER,
+	TRADE_SYMBOL_EXOTIC_MATTER,
+	TRADE_SYMBOL_ADVANCED_CIRCUITRY,
+	TRADE_SYMBOL_GRAVITON_EMITTERS,
+	TRADE_SYMBOL_IRON,
+	TRADE_SYMBOL_IRON_ORE,
+	TRADE_SYMBOL_COPPER,
+	TRADE_SYMBOL_COPPER_ORE,
+	TRADE_SYMBOL_ALUMINUM,
+	TRADE_SYMBOL_ALUMINUM_ORE,
+	TRADE_SYMBOL_SILVER,
+	TRADE_SYMBOL_SILVER_ORE,
+	TRADE_SYMBOL_GOLD,
+	TRADE_SYMBOL_GOLD_ORE,
+	TRADE_SYMBOL_PLATINUM,
+	TRADE_SYMBOL_PLATINUM_ORE,
+	TRADE_SYMBOL_DIAMONDS,
+	TRADE_SYMBOL_URANITE,
+	TRADE_SYMBOL_URANITE_ORE,
+	TRADE_SYMBOL_MERITIUM,
+	TRADE_SYMBOL_MERITIUM_ORE,
+	TRADE_SYMBOL_HYDROCARBON,
+	TRADE_SYMBOL_ANTIMATTER,
+	TRADE_SYMBOL_FAB_MATS,
+	TRADE_SYMBOL_FERTILIZERS,
+	TRADE_SYMBOL_FABRICS,
+	TRADE_SYMBOL_FOOD,
+	TRADE_SYMBOL_JEWELRY,
+	TRADE_SYMBOL_MACHINERY,
+	TRADE_SYMBOL_FIREARMS,
+	TRADE_SYMBOL_ASSAULT_RIFLES,
+	TRADE_SYMBOL_MILITARY_EQUIPMENT,
+	TRADE_SYMBOL_EXPLOSIVES,
+	TRADE_SYMBOL_LAB_INSTRUMENTS,
+	TRADE_SYMBOL_AMMUNITION,
+	TRADE_SYMBOL_ELECTRONICS,
+	TRADE_SYMBOL_SHIP_PLATING,
+	TRADE_SYMBOL_SHIP_PARTS,
+	TRADE_SYMBOL_EQUIPMENT,
+	TRADE_SYMBOL_FUEL,
+	TRADE_SYMBOL_MEDICINE,
+	TRADE_SYMBOL_DRUGS,
+	TRADE_SYMBOL_CLOTHING,
+	TRADE_SYMBOL_MICROPROCESSORS,
+	TRADE_SYMBOL_PLASTICS,
+	TRADE_SYMBOL_POLYNUCLEOTIDES,
+	TRADE_SYMBOL_BIOCOMPOSITES,
+	TRADE_SYMBOL_QUANTUM_STABILIZERS,
+	TRADE_SYMBOL_NANOBOTS,
+	TRADE_SYMBOL_AI_MAINFRAMES,
+	TRADE_SYMBOL_QUANTUM_DRIVES,
+	TRADE_SYMBOL_ROBOTIC_DRONES,
+	TRADE_SYMBOL_CYBER_IMPLANTS,
+	TRADE_SYMBOL_GENE_THERAPEUTICS,
+	TRADE_SYMBOL_NEURAL_CHIPS,
+	TRADE_SYMBOL_MOOD_REGULATORS,
+	TRADE_SYMBOL_VIRAL_AGENTS,
+	TRADE_SYMBOL_MICRO_FUSION_GENERATORS,
+	TRADE_SYMBOL_SUPERGRAINS,
+	TRADE_SYMBOL_LASER_RIFLES,
+	TRADE_SYMBOL_HOLOGRAPHICS,
+	TRADE_SYMBOL_SHIP_SALVAGE,
+	TRADE_SYMBOL_RELIC_TECH,
+	TRADE_SYMBOL_NOVEL_LIFEFORMS,
+	TRADE_SYMBOL_BOTANICAL_SPECIMENS,
+	TRADE_SYMBOL_CULTURAL_ARTIFACTS,
+	TRADE_SYMBOL_FRAME_PROBE,
+	TRADE_SYMBOL_FRAME_DRONE,
+	TRADE_SYMBOL_FRAME_INTERCEPTOR,
+	TRADE_SYMBOL_FRAME_RACER,
+	TRADE_SYMBOL_FRAME_FIGHTER,
+	TRADE_SYMBOL_FRAME_FRIGATE,
+	TRADE_SYMBOL_FRAME_SHUTTLE,
+	TRADE_SYMBOL_FRAME_EXPLORER,
+	TRADE_SYMBOL_FRAME_MINER,
+	TRADE_SYMBOL_FRAME_LIGHT_FREIGHTER,
+	TRADE_SYMBOL_FRAME_HEAVY_FREIGHTER,
+	TRADE_SYMBOL_FRAME_TRANSPORT,
+	TRADE_SYMBOL_FRAME_DESTROYER,
+	TRADE_SYMBOL_FRAME_CRUISER,
+	TRADE_SYMBOL_FRAME_CARRIER,
+	TRADE_SYMBOL_REACTOR_SOLAR_I,
+	TRADE_SYMBOL_REACTOR_FUSION_I,
+	TRADE_SYMBOL_REACTOR_FISSION_I,
+	TRADE_SYMBOL_REACTOR_CHEMICAL_I,
+	TRADE_SYMBOL_REACTOR_ANTIMATTER_I,
+	TRADE_SYMBOL_ENGINE_IMPULSE_DRIVE_I,
+	TRADE_SYMBOL_ENGINE_ION_DRIVE_I,
+	TRADE_SYMBOL_ENGINE_ION_DRIVE_II,
+	TRADE_SYMBOL_ENGINE_HYPER_DRIVE_I,
+	TRADE_SYMBOL_MODULE_MINERAL_PROCESSOR_I,
+	TRADE_SYMBOL_MODULE_GAS_PROCESSOR_I,
+	TRADE_SYMBOL_MODULE_CARGO_HOLD_I,
+	TRADE_SYMBOL_MODULE_CARGO_HOLD_II,
+	TRADE_SYMBOL_MODULE_CARGO_HOLD_III,
+	TRADE_SYMBOL_MODULE_CREW_QUARTERS_I,
+	TRADE_SYMBOL_MODULE_ENVOY_QUARTERS_I,
+	TRADE_SYMBOL_MODULE_PASSENGER_CABIN_I,
+	TRADE_SYMBOL_MODULE_MICRO_REFINERY_I,
+	TRADE_SYMBOL_MODULE_SCIENCE_LAB_I,
+	TRADE_SYMBOL_MODULE_JUMP_DRIVE_I,
+	TRADE_SYMBOL_MODULE_JUMP_DRIVE_II,
+	TRADE_SYMBOL_MODULE_JUMP_DRIVE_III,
+	TRADE_SYMBOL_MODULE_WARP_DRIVE_I,
+	TRADE_SYMBOL_MODULE_WARP_DRIVE_II,
+	TRADE_SYMBOL_MODULE_WARP_DRIVE_III,
+	TRADE_SYMBOL_MODULE_SHIELD_GENERATOR_I,
+	TRADE_SYMBOL_MODULE_SHIELD_GENERATOR_II,
+	TRADE_SYMBOL_MODULE_ORE_REFINERY_I,
+	TRADE_SYMBOL_MODULE_FUEL_REFINERY_I,
+	TRADE_SYMBOL_MOUNT_GAS_SIPHON_I,
+	TRADE_SYMBOL_MOUNT_GAS_SIPHON_II,
+	TRADE_SYMBOL_MOUNT_GAS_SIPHON_III,
+	TRADE_SYMBOL_MOUNT_SURVEYOR_I,
+	TRADE_SYMBOL_MOUNT_SURVEYOR_II,
+	TRADE_SYMBOL_MOUNT_SURVEYOR_III,
+	TRADE_SYMBOL_MOUNT_SENSOR_ARRAY_I,
+	TRADE_SYMBOL_MOUNT_SENSOR_ARRAY_II,
+	TRADE_SYMBOL_MOUNT_SENSOR_ARRAY_III,
+	TRADE_SYMBOL_MOUNT_MINING_LASER_I,
+	TRADE_SYMBOL_MOUNT_MINING_LASER_II,
+	TRADE_SYMBOL_MOUNT_MINING_LASER_III,
+	TRADE_SYMBOL_MOUNT_LASER_CANNON_I,
+	TRADE_SYMBOL_MOUNT_MISSILE_LAUNCHER_I,
+	TRADE_SYMBOL_MOUNT_TURRET_I,
+	TRADE_SYMBOL_SHIP_PROBE,
+	TRADE_SYMBOL_SHIP_MINING_DRONE,
+	TRADE_SYMBOL_SHIP_SIPHON_DRONE,
+	TRADE_SYMBOL_SHIP_INTERCEPTOR,
+	TRADE_SYMBOL_SHIP_LIGHT_HAULER,
+	TRADE_SYMBOL_SHIP_COMMAND_FRIGATE,
+	TRADE_SYMBOL_SHIP_EXPLORER,
+	TRADE_SYMBOL_SHIP_HEAVY_FREIGHTER,
+	TRADE_SYMBOL_SHIP_LIGHT_SHUTTLE,
+	TRADE_SYMBOL_SHIP_ORE_HOUND,
+	TRADE_SYMBOL_SHIP_REFINING_FREIGHTER,
+	TRADE_SYMBOL_SHIP_SURVEYOR,
+}
+
+// Every TradeSymbol this package knows about.
+func AllTradeSymbols() []TradeSymbol {
+	return append([]TradeSymbol(nil), knownTradeSymbols...)
+}
+
+func (self TradeSymbol) IsKnown() bool {
+	for _, value := range knownTradeSymbols {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self TradeSymbol) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a TradeSymbol.", UnknownEnumError, string(self))
+}
+
+// A waypoint is a location that ships can travel to such as a Planet, Moon or Space Station.
+type Waypoint struct {
+	Chart   *Chart           `json:"chart,omitempty"`
+	Faction *WaypointFaction `json:"faction,omitempty"`
+	// True if the waypoint is under construction.
+	IsUnderConstruction bool `json:"isUnderConstruction"`
+	// The modifiers of the waypoint.
+	Modifiers []WaypointModifier `json:"modifiers,omitempty"`
+	// Waypoints that orbit this waypoint.
+	Orbitals []WaypointOrbital `json:"orbitals"`
+	// The symbol of the parent waypoint, if this waypoint is in orbit around another waypoint. Otherwise this value is undefined.
+	Orbits       string         `json:"orbits,omitempty"`
+	Symbol       WaypointSymbol `json:"symbol"`
+	SystemSymbol SystemSymbol   `json:"systemSymbol"`
+	// The traits of the waypoint.
+	Traits []WaypointTrait `json:"traits"`
+	Type   WaypointType    `json:"type"`
+	// Relative position of the waypoint on the system's x axis. This is not an absolute position in the universe.
+	X int `json:"x"`
+	// Relative position of the waypoint on the system's y axis. This is not an absolute position in the universe.
+	Y int `json:"y"`
+}
+
+type WaypointFaction struct {
+	Symbol FactionSymbol `json:"symbol"`
+}
+
+type WaypointModifier struct {
+	// A description of the trait.
+	Description string `json:"description"`
+	// The name of the trait.
+	Name   string                 `json:"name"`
+	Symbol WaypointModifierSymbol `json:"symbol"`
+}
+
+// The unique identifier of the modifier.
+type WaypointModifierSymbol string
+
+const (
+	WAYPOINT_MODIFIER_STRIPPED       WaypointModifierSymbol = "STRIPPED"
+	WAYPOINT_MODIFIER_UNSTABLE       WaypointModifierSymbol = "UNSTABLE"
+	WAYPOINT_MODIFIER_RADIATION_LEAK WaypointModifierSymbol = "RADIATION_LEAK"
+	WAYPOINT_MODIFIER_CRITICAL_LIMIT WaypointModifierSymbol = "CRITICAL_LIMIT"
+	WAYPOINT_MODIFIER_CIVIL_UNREST   WaypointModifierSymbol = "CIVIL_UNREST"
+)
+
+var knownWaypointModifierSymbols = []WaypointModifierSymbol{
+	WAYPOINT_MODIFIER_STRIPPED,
+	WAYPOINT_MODIFIER_UNSTABLE,
+	WAYPOINT_MODIFIER_RADIATION_LEAK,
+	WAYPOINT_MODIFIER_CRITICAL_LIMIT,
+	WAYPOINT_MODIFIER_CIVIL_UNREST,
+}
+
+// Every WaypointModifierSymbol this package knows about.
+func AllWaypointModifierSymbols() []WaypointModifierSymbol {
+	return append([]WaypointModifierSymbol(nil), knownWaypointModifierSymbols...)
+}
+
+func (self WaypointModifierSymbol) IsKnown() bool {
+	for _, value := range knownWaypointModifierSymbols {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self WaypointModifierSymbol) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a WaypointModifierSymbol.", UnknownEnumError, string(self))
+}
+
+// An orbital is another waypoint that orbits a parent waypoint.
+type WaypointOrbital struct {
+	// The symbol of the orbiting waypoint.
+	Symbol string `json:"symbol"`
+}
+
+// The symbol of the waypoint.
+type WaypointSymbol = string
+
+type WaypointTrait struct {
+	// A description of the trait.
+	Description string `json:"description"`
+	// The name of the trait.
+	Name   string              `json:"name"`
+	Symbol WaypointTraitSymbol `json:"symbol"`
+}
+
+// The unique identifier of the trait.
+type WaypointTraitSymbol string
+
+const (
+	WAYPOINT_TRAIT_UNCHARTED               WaypointTraitSymbol = "UNCHARTED"
+	WAYPOINT_TRAIT_UNDER_CONSTRUCTION      WaypointTraitSymbol = "UNDER_CONSTRUCTION"
+	WAYPOINT_TRAIT_MARKETPLACE             WaypointTraitSymbol = "MARKETPLACE"
+	WAYPOINT_TRAIT_SHIPYARD                WaypointTraitSymbol = "SHIPYARD"
+	WAYPOINT_TRAIT_OUTPOST                 WaypointTraitSymbol = "OUTPOST"
+	WAYPOINT_TRAIT_SCATTERED_SETTLEMENTS   WaypointTraitSymbol = "SCATTERED_SETTLEMENTS"
+	WAYPOINT_TRAIT_SPRAWLING_CITIES        WaypointTraitSymbol = "SPRAWLING_CITIES"
+	WAYPOINT_TRAIT_MEGA_STRUCTURES         WaypointTraitSymbol = "MEGA_STRUCTURES"
+	WAYPOINT_TRAIT_PIRATE_BASE             WaypointTraitSymbol = "PIRATE_BASE"
+	WAYPOINT_TRAIT_OVERCROWDED             WaypointTraitSymbol = "OVERCROWDED"
+	WAYPOINT_TRAIT_HIGH_TECH               WaypointTraitSymbol = "HIGH_TECH"
+	WAYPOINT_TRAIT_CORRUPT                 WaypointTraitSymbol = "CORRUPT"
+	WAYPOINT_TRAIT_BUREAUCRATIC            WaypointTraitSymbol = "BUREAUCRATIC"
+	WAYPOINT_TRAIT_TRADING_HUB             WaypointTraitSymbol = "TRADING_HUB"
+	WAYPOINT_TRAIT_INDUSTRIAL              WaypointTraitSymbol = "INDUSTRIAL"
+	WAYPOINT_TRAIT_BLACK_MARKET            WaypointTraitSymbol = "BLACK_MARKET"
+	WAYPOINT_TRAIT_RESEARCH_FACILITY       WaypointTraitSymbol = "RESEARCH_FACILITY"
+	WAYPOINT_TRAIT_MILITARY_BASE           WaypointTraitSymbol = "MILITARY_BASE"
+	WAYPOINT_TRAIT_SURVEILLANCE_OUTPOST    WaypointTraitSymbol = "SURVEILLANCE_OUTPOST"
+	WAYPOINT_TRAIT_EXPLORATION_OUTPOST     WaypointTraitSymbol = "EXPLORATION_OUTPOST"
+	WAYPOINT_TRAIT_MINERAL_DEPOSITS        WaypointTraitSymbol = "MINERAL_DEPOSITS"
+	WAYPOINT_TRAIT_COMMON_METAL_DEPOSITS   WaypointTraitSymbol = "COMMON_METAL_DEPOSITS"
+	WAYPOINT_TRAIT_PRECIOUS_METAL_DEPOSITS WaypointTraitSymbol = "PRECIOUS_METAL_DEPOSITS"
+	WAYPOINT_TRAIT_RARE_METAL_DEPOSITS     WaypointTraitSymbol = "RARE_METAL_DEPOSITS"
+	WAYPOINT_TRAIT_METHANE_POOLS           WaypointTraitSymbol = "METHANE_POOLS"
+	WAYPOINT_TRAIT_ICE_CRYSTALS            WaypointTraitSymbol = "ICE_CRYSTALS"
+	WAYPOINT_TRAIT_EXPLOSIVE_GASES         WaypointTraitSymbol = "EXPLOSIVE_GASES"
+	WAYPOINT_TRAIT_STRONG_MAGNETOSPHERE    WaypointTraitSymbol = "STRONG_MAGNETOSPHERE"
+	WAYPOINT_TRAIT_VIBRANT_AURORAS         WaypointTraitSymbol = "VIBRANT_AURORAS"
+	WAYPOINT_TRAIT_SALT_FLATS              WaypointTraitSymbol = "SALT_FLATS"
+	WAYPOINT_TRAIT_CANYONS                 WaypointTraitSymbol = "CANYONS"
+	WAYPOINT_TRAIT_PERPETUAL_DAYLIGHT      WaypointTraitSymbol = "PERPETUAL_DAYLIGHT"
+	WAYPOINT_TRAIT_PERPETUAL_OVERCAST      WaypointTraitSymbol = "PERPETUAL_OVERCAST"
+	WAYPOINT_TRAIT_DRY_SEABEDS             WaypointTraitSymbol = "DRY_SEABEDS"
+	WAYPOINT_TRAIT_MAGMA_SEAS              WaypointTraitSymbol = "MAGMA_SEAS"
+	WAYPOINT_TRAIT_SUPERVOLCANOES          WaypointTraitSymbol = "SUPERVOLCANOES"
+	WAYPOINT_TRAIT_ASH_CLOUDS              WaypointTraitSymbol = "ASH_CLOUDS"
+	WAYPOINT_TRAIT_VAST_RUINS              WaypointTraitSymbol = "VAST_RUINS"
+	WAYPOINT_TRAIT_MUTATED_FLORA           WaypointTraitSymbol = "MUTATED_FLORA"
+	WAYPOINT_TRAIT_TERRAFORMED             WaypointTraitSymbol = "TERRAFORMED"
+	WAYPOINT_TRAIT_EXTREME_TEMPERATURES    WaypointTraitSymbol = "EXTREME_TEMPERATURES"
+	WAYPOINT_TRAIT_EXTREME_PRESSURE        WaypointTraitSymbol = "EXTREME_PRESSURE"
+	WAYPOINT_TRAIT_DIVERSE_LIFE            WaypointTraitSymbol = "DIVERSE_LIFE"
+	WAYPOINT_TRAIT_SCARCE_LIFE             WaypointTraitSymbol = "SCARCE_LIFE"
+	WAYPOINT_TRAIT_FOSSILS                 WaypointTraitSymbol = "FOSSILS"
+	WAYPOINT_TRAIT_WEAK_GRAVITY            WaypointTraitSymbol = "WEAK_GRAVITY"
+	WAYPOINT_TRAIT_STRONG_GRAVITY          WaypointTraitSymbol = "STRONG_GRAVITY"
+	WAYPOINT_TRAIT_CRUSHING_GRAVITY        WaypointTraitSymbol = "CRUSHING_GRAVITY"
+	WAYPOINT_TRAIT_TOXIC_ATMOSPHERE        WaypointTraitSymbol = "TOXIC_ATMOSPHERE"
+	WAYPOINT_TRAIT_CORROSIVE_ATMOSPHERE    WaypointTraitSymbol = "CORROSIVE_ATMOSPHERE"
+	WAYPOINT_TRAIT_BREATHABLE_ATMOSPHERE   WaypointTraitSymbol = "BREATHABLE_ATMOSPHERE"
+	WAYPOINT_TRAIT_THIN_ATMOSPHERE         WaypointTraitSymbol = "THIN_ATMOSPHERE"
+	WAYPOINT_TRAIT_JOVIAN                  WaypointTraitSymbol = "JOVIAN"
+	WAYPOINT_TRAIT_ROCKY                   WaypointTraitSymbol = "ROCKY"
+	WAYPOINT_TRAIT_VOLCANIC                WaypointTraitSymbol = "VOLCANIC"
+	WAYPOINT_TRAIT_FROZEN                  WaypointTraitSymbol = "FROZEN"
+	WAYPOINT_TRAIT_SWAMP                   WaypointTraitSymbol = "SWAMP"
+	WAYPOINT_TRAIT_BARREN                  WaypointTraitSymbol = "BARREN"
+	WAYPOINT_TRAIT_TEMPERATE               WaypointTraitSymbol = "TEMPERATE"
+	WAYPOINT_TRAIT_JUNGLE                  WaypointTraitSymbol = "JUNGLE"
+	WAYPOINT_TRAIT_OCEAN                   WaypointTraitSymbol = "OCEAN"
+	WAYPOINT_TRAIT_RADIOACTIVE             WaypointTraitSymbol = "RADIOACTIVE"
+	WAYPOINT_TRAIT_MICRO_GRAVITY_ANOMALIES WaypointTraitSymbol = "MICRO_GRAVITY_ANOMALIES"
+	WAYPOINT_TRAIT_DEBRIS_CLUSTER          WaypointTraitSymbol = "DEBRIS_CLUSTER"
+	WAYPOINT_TRAIT_DEEP_CRATERS            WaypointTraitSymbol = "DEEP_CRATERS"
+	WAYPOINT_TRAIT_SHALLOW_CRATERS         WaypointTraitSymbol = "SHALLOW_CRATERS"
+	WAYPOINT_TRAIT_UNSTABLE_COMPOSITION    WaypointTraitSymbol = "UNSTABLE_COMPOSITION"
+	WAYPOINT_TRAIT_HOLLOWED_INTERIOR       WaypointTraitSymbol = "HOLLOWED_INTERIOR"
+	WAYPOINT_TRAIT_STRIPPED                WaypointTraitSymbol = "STRIPPED"
+)
+
+var knownWaypointTraitSymbols = []WaypointTraitSymbol{
+	WAYPOINT_TRAIT_UNCHARTED,
+	WAYPOINT_TRAIT_UNDER_CONSTRUCTION,
+	WAYPOINT_TRAIT_MARKETPLACE,
+	WAYPOINT_TRAIT_SHIPYARD,
+	WAYPOINT_TRAIT_OUTPOST,
+	WAYPOINT_TRAIT_SCATTERED_SETTLEMENTS,
+	WAYPOINT_TRAIT_SPRAWLING_CITIES,
+	WAYPOINT_TRAIT_MEGA_STRUCTURES,
+	WAYPOINT_TRAIT_PIRATE_BASE,
+	WAYPOINT_TRAIT_OVERCROWDED,
+	WAYPOINT_TRAIT_HIGH_TECH,
+	WAYPOINT_TRAIT_CORRUPT,
+	WAYPOINT_TRAIT_BUREAUCRATIC,
+	WAYPOINT_TRAIT_TRADING_HUB,
+	WAYPOINT_TRAIT_INDUSTRIAL,
+	WAYPOINT_TRAIT_BLACK_MARKET,
+	WAYPOINT_TRAIT_RESEARCH_FACILITY,
+	WAYPOINT_TRAIT_MILITARY_BASE,
+	WAYPOINT_TRAIT_SURVEILLANCE_OUTPOST,
+	WAYPOINT_TRAIT_EXPLORATION_OUTPOST,
+	WAYPOINT_TRAIT_MINERAL_DEPOSITS,
+	WAYPOINT_TRAIT_COMMON_METAL_DEPOSITS,
+	WAYPOINT_TRAIT_PRECIOUS_METAL_DEPOSITS,
+	WAYPOINT_TRAIT_RARE_METAL_DEPOSITS,
+	WAYPOINT_TRAIT_METHANE_POOLS,
+	WAYPOINT_TRAIT_ICE_CRYSTALS,
+	WAYPOINT_TRAIT_EXPLOSIVE_GASES,
+	WAYPOINT_TRAIT_STRONG_MAGNETOSPHERE,
+	WAYPOINT_TRAIT_VIBRANT_AURORAS,
+	WAYPOINT_TRAIT_SALT_FLATS,
+	WAYPOINT_TRAIT_CANYONS,
+	WAYPOINT_TRAIT_PERPETUAL_DAYLIGHT,
+	WAYPOINT_TRAIT_PERPETUAL_OVERCAST,
+	WAYPOINT_TRAIT_DRY_SEABEDS,
+	WAYPOINT_TRAIT_MAGMA_SEAS,
+	WAYPOINT_TRAIT_SUPERVOLCANOES,
+	WAYPOINT_TRAIT_ASH_CLOUDS,
+	WAYPOINT_TRAIT_VAST_RUINS,
+	WAYPOINT_TRAIT_MUTATED_FLORA,
+	WAYPOINT_TRAIT_TERRAFORMED,
+	WAYPOINT_TRAIT_EXTREME_TEMPERATURES,
+	WAYPOINT_TRAIT_EXTREME_PRESSURE,
+	WAYPOINT_TRAIT_DIVERSE_LIFE,
+	WAYPOINT_TRAIT_SCARCE_LIFE,
+	WAYPOINT_TRAIT_FOSSILS,
+	WAYPOINT_TRAIT_WEAK_GRAVITY,
+	WAYPOINT_TRAIT_STRONG_GRAVITY,
+	WAYPOINT_TRAIT_CRUSHING_GRAVITY,
+	WAYPOINT_TRAIT_TOXIC_ATMOSPHERE,
+	WAYPOINT_TRAIT_CORROSIVE_ATMOSPHERE,
+	WAYPOINT_TRAIT_BREATHABLE_ATMOSPHERE,
+	WAYPOINT_TRAIT_THIN_ATMOSPHERE,
+	WAYPOINT_TRAIT_JOVIAN,
+	WAYPOINT_TRAIT_ROCKY,
+	WAYPOINT_TRAIT_VOLCANIC,
+	WAYPOINT_TRAIT_FROZEN,
+	WAYPOINT_TRAIT_SWAMP,
+	WAYPOINT_TRAIT_BARREN,
+	WAYPOINT_TRAIT_TEMPERATE,
+	WAYPOINT_TRAIT_JUNGLE,
+	WAYPOINT_TRAIT_OCEAN,
+	WAYPOINT_TRAIT_RADIOACTIVE,
+	WAYPOINT_TRAIT_MICRO_GRAVITY_ANOMALIES,
+	WAYPOINT_TRAIT_DEBRIS_CLUSTER,
+	WAYPOINT_TRAIT_DEEP_CRATERS,
+	WAYPOINT_TRAIT_SHALLOW_CRATERS,
+	WAYPOINT_TRAIT_UNSTABLE_COMPOSITION,
+	WAYPOINT_TRAIT_HOLLOWED_INTERIOR,
+	WAYPOINT_TRAIT_STRIPPED,
+}
+
+// Every WaypointTraitSymbol this package knows about.
+func AllWaypointTraitSymbols() []WaypointTraitSymbol {
+	return append([]WaypointTraitSymbol(nil), knownWaypointTraitSymbols...)
+}
+
+func (self WaypointTraitSymbol) IsKnown() bool {
+	for _, value := range knownWaypointTraitSymbols {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self WaypointTraitSymbol) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a WaypointTraitSymbol.", UnknownEnumError, string(self))
+}
+
+// The type of waypoint.
+type WaypointType string
+
+const (
+	WAYPOINT_TYPE_PLANET                  WaypointType = "PLANET"
+	WAYPOINT_TYPE_GAS_GIANT               WaypointType = "GAS_GIANT"
+	WAYPOINT_TYPE_MOON                    WaypointType = "MOON"
+	WAYPOINT_TYPE_ORBITAL_STATION         WaypointType = "ORBITAL_STATION"
+	WAYPOINT_TYPE_JUMP_GATE               WaypointType = "JUMP_GATE"
+	WAYPOINT_TYPE_ASTEROID_FIELD          WaypointType = "ASTEROID_FIELD"
+	WAYPOINT_TYPE_ASTEROID                WaypointType = "ASTEROID"
+	WAYPOINT_TYPE_ENGINEERED_ASTEROID     WaypointType = "ENGINEERED_ASTEROID"
+	WAYPOINT_TYPE_ASTEROID_BASE           WaypointType = "ASTEROID_BASE"
+	WAYPOINT_TYPE_NEBULA                  WaypointType = "NEBULA"
+	WAYPOINT_TYPE_DEBRIS_FIELD            WaypointType = "DEBRIS_FIELD"
+	WAYPOINT_TYPE_GRAVITY_WELL            WaypointType = "GRAVITY_WELL"
+	WAYPOINT_TYPE_ARTIFICIAL_GRAVITY_WELL WaypointType = "ARTIFICIAL_GRAVITY_WELL"
+	WAYPOINT_TYPE_FUEL_STATION            WaypointType = "FUEL_STATION"
+)
+
+var knownWaypointTypes = []WaypointType{
+	WAYPOINT_TYPE_PLANET,
+	WAYPOINT_TYPE_GAS_GIANT,
+	WAYPOINT_TYPE_MOON,
+	WAYPOINT_TYPE_ORBITAL_STATION,
+	WAYPOINT_TYPE_JUMP_GATE,
+	WAYPOINT_TYPE_ASTEROID_FIELD,
+	WAYPOINT_TYPE_ASTEROID,
+	WAYPOINT_TYPE_ENGINEERED_ASTEROID,
+	WAYPOINT_TYPE_ASTEROID_BASE,
+	WAYPOINT_TYPE_NEBULA,
+	WAYPOINT_TYPE_DEBRIS_FIELD,
+	WAYPOINT_TYPE_GRAVITY_WELL,
+	WAYPOINT_TYPE_ARTIFICIAL_GRAVITY_WELL,
+	WAYPOINT_TYPE_FUEL_STATION,
+}
+
+// Every WaypointType this package knows about.
+func AllWaypointTypes() []WaypointType {
+	return append([]WaypointType(nil), knownWaypointTypes...)
+}
+
+func (self WaypointType) IsKnown() bool {
+	for _, value := range knownWaypointTypes {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self WaypointType) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a WaypointType.", UnknownEnumError, string(self))
+}
+
+type GetStatusResponse struct {
+	Announcements []GetStatusResponseAnnouncementsItem `json:"announcements"`
+	Description   string                               `json:"description"`
+	Leaderboards  *GetStatusResponseLeaderboards       `json:"leaderboards"`
+	Links         []GetStatusResponseLinksItem         `json:"links"`
+	// The date when the game server was last reset.
+	ResetDate    string                         `json:"resetDate"`
+	ServerResets *GetStatusResponseServerResets `json:"serverResets"`
+	Stats        *GetStatusResponseStats        `json:"stats"`
+	// The current status of the game server.
+	Status string `json:"status"`
+	// The current version of the API.
+	Version string `json:"version"`
+}
+
+type GetAgentsResponse struct {
+	Data []Agent `json:"data"`
+	Meta *Meta   `json:"meta"`
+}
+
+type GetFactionsResponse struct {
+	Data []Faction `json:"data"`
+	Meta *Meta     `json:"meta"`
+}
+
+type GetContractsResponse struct {
+	Data []Contract `json:"data"`
+	Meta *Meta      `json:"meta"`
+}
+
+type AcceptContractResponse struct {
+	Agent    *Agent    `json:"agent"`
+	Contract *Contract `json:"contract"`
+}
+
+type DeliverContractRequest struct {
+	// Symbol of a ship located in the destination to deliver a contract and that has a good to deliver in its cargo.
+	ShipSymbol string `json:"shipSymbol"`
+	// The symbol of the good to deliver.
+	TradeSymbol string `json:"tradeSymbol"`
+	// Amount of units to deliver.
+	Units int `json:"units"`
+}
+
+type DeliverContractResponse struct {
+	Cargo    *ShipCargo `json:"cargo"`
+	Contract *Contract  `json:"contract"`
+}
+
+type FulfillContractResponse struct {
+	Agent    *Agent    `json:"agent"`
+	Contract *Contract `json:"contract"`
+}
+
+type GetMyFactionsResponse struct {
+	Data []GetMyFactionsResponseDataItem `json:"data"`
+	Meta *Meta                           `json:"meta"`
+}
+
+type GetMyShipsResponse struct {
+	Data []Ship `json:"data"`
+	Meta *Meta  `json:"meta"`
+}
+
+type PurchaseShipRequest struct {
+	ShipType ShipType `json:"shipType"`
+	// The symbol of the waypoint you want to purchase the ship at.
+	WaypointSymbol string `json:"waypointSymbol"`
+}
+
+type PurchaseShipResponse struct {
+	Agent       *Agent               `json:"agent"`
+	Ship        *Ship                `json:"ship"`
+	Transaction *ShipyardTransaction `json:"transaction"`
+}
+
+type CreateChartResponse struct {
+	Chart    *Chart    `json:"chart"`
+	Waypoint *Waypoint `json:"waypoint"`
+}
+
+type DockShipResponse struct {
+	Nav *ShipNav `json:"nav"`
+}
+
+type ExtractResourcesResponse struct {
+	Cargo      *ShipCargo           `json:"cargo"`
+	Cooldown   *Cooldown            `json:"cooldown"`
+	Events     []ShipConditionEvent `json:"events"`
+	Extraction *Extraction          `json:"extraction"`
+}
+
+type ExtractResourcesWithSurveyResponse struct {
+	Cargo      *ShipCargo           `json:"cargo"`
+	Cooldown   *Cooldown            `json:"cooldown"`
+	Events     []ShipConditionEvent `json:"events"`
+	Extraction *Extraction          `json:"extraction"`
+}
+
+type JettisonRequest struct {
+	Symbol TradeSymbol `json:"symbol"`
+	// Amount of units to jettison of this good.
+	Units int `json:"units"`
+}
+
+type JettisonResponse struct {
+	Cargo *ShipCargo `json:"cargo"`
+}
+
+type JumpShipRequest struct {
+	// The symbol of the waypoint to jump to. The destination must be a connected waypoint.
+	WaypointSymbol string `json:"waypointSymbol"`
+}
+
+type JumpShipResponse struct {
+	Agent       *Agent             `json:"agent"`
+	Cooldown    *Cooldown          `json:"cooldown"`
+	Nav         *ShipNav           `json:"nav"`
+	Transaction *MarketTransaction `json:"transaction"`
+}
+
+type InstallShipModuleRequest struct {
+	Symbol string `json:"symbol"`
+}
+
+type InstallShipModuleResponse struct {
+	Agent       *Agent                       `json:"agent"`
+	Cargo       *ShipCargo                   `json:"cargo"`
+	Modules     []ShipModule                 `json:"modules"`
+	Transaction *ShipModificationTransaction `json:"transaction"`
+}
+
+type RemoveShipModuleRequest struct {
+	// The symbol of the module to remove.
+	Symbol string `json:"symbol"`
+}
+
+type RemoveShipModuleResponse struct {
+	Agent       *Agent                       `json:"agent"`
+	Cargo       *ShipCargo                   `json:"cargo"`
+	Modules     []ShipModule                 `json:"modules"`
+	Transaction *ShipModificationTransaction `json:"transaction"`
+}
+
+type InstallMountRequest struct {
+	Symbol string `json:"symbol"`
+}
+
+type InstallMountResponse struct {
+	Agent *Agent     `json:"agent"`
+	Cargo *ShipCargo `json:"cargo"`
+	// List of installed mounts after the installation of the new mount.
+	Mounts      []ShipMount                  `json:"mounts"`
+	Transaction *ShipModificationTransaction `json:"transaction"`
+}
+
+type RemoveMountRequest struct {
+	// The symbol of the mount to remove.
+	Symbol string `json:"symbol"`
+}
+
+type RemoveMountResponse struct {
+	Agent *Agent     `json:"agent"`
+	Cargo *ShipCargo `json:"cargo"`
+	// List of installed mounts after the removal of the selected mount.
+	Mounts      []ShipMount                  `json:"mounts"`
+	Transaction *ShipModificationTransaction `json:"transaction"`
+}
+
+type PatchShipNavRequest struct {
+	FlightMode ShipNavFlightMode `json:"flightMode,omitempty"`
+}
+
+type NavigateShipRequest struct {
+	// The target destination.
+	WaypointSymbol string `json:"waypointSymbol"`
+}
+
+type NavigateShipResponse struct {
+	Events []ShipConditionEvent `json:"events"`
+	Fuel   *ShipFuel            `json:"fuel"`
+	Nav    *ShipNav             `json:"nav"`
+}
+
+type NegotiateContractResponse struct {
+	Contract *Contract `json:"contract"`
+}
+
+type OrbitShipResponse struct {
+	Nav *ShipNav `json:"nav"`
+}
+
+type PurchaseCargoRequest struct {
+	Symbol TradeSymbol `json:"symbol"`
+	// The number of units of the good to purchase.
+	Units int `json:"units"`
+}
+
+type PurchaseCargoResponse struct {
+	Agent       *Agent             `json:"agent"`
+	Cargo       *ShipCargo         `json:"cargo"`
+	Transaction *MarketTransaction `json:"transaction"`
+}
+
+type ShipRefineRequest struct {
+	// The type of good to produce out of the refining process.
+	Produce TradeSymbol `json:"produce"`
+}
+
+type ShipRefineResponse struct {
+	Cargo *ShipCargo `json:"cargo"`
+	// Goods that were consumed during this refining process.
+	Consumed []ShipRefineResponseConsumedItem `json:"consumed"`
+	Cooldown *Cooldown                        `json:"cooldown"`
+	// Goods that were produced by this refining process.
+	Produced []ShipRefineResponseProducedItem `json:"produced"`
+}
+
+type RefuelShipRequest struct {
+	// Wether to use the FUEL thats in your cargo or not. Default: false
+	FromCargo bool `json:"fromCargo,omitempty"`
+	// The amount of fuel to fill in the ship's tanks. When not specified, the ship will be refueled to its maximum fuel capacity. If the amount specified is greater than the ship's remaining capacity, the ship will only be refueled to its maximum fuel capacity. The amount specified is not in market units but in ship fuel units.
+	Units int `json:"units,omitempty"`
+}
+
+type RefuelShipResponse struct {
+	Agent       *Agent             `json:"agent"`
+	Fuel        *ShipFuel          `json:"fuel"`
+	Transaction *MarketTransaction `json:"transaction"`
+}
+
+type GetRepairShipResponse struct {
+	Transaction *RepairTransaction `json:"transaction"`
+}
+
+type RepairShipResponse struct {
+	Agent       *Agent             `json:"agent"`
+	Ship        *Ship              `json:"ship"`
+	Transaction *RepairTransaction `json:"transaction"`
+}
+
+type CreateShipShipScanResponse struct {
+	Cooldown *Cooldown `json:"cooldown"`
+	// List of scanned ships.
+	Ships []ScannedShip `json:"ships"`
+}
+
+type CreateShipSystemScanResponse struct {
+	Cooldown *Cooldown `json:"cooldown"`
+	// List of scanned systems.
+	Systems []ScannedSystem `json:"systems"`
+}
+
+type CreateShipWaypointScanResponse struct {
+	Cooldown *Cooldown `json:"cooldown"`
+	// List of scanned waypoints.
+	Waypoints []ScannedWaypoint `json:"waypoints"`
+}
+
+type GetScrapShipResponse struct {
+	Transaction *ScrapTransaction `json:"transaction"`
+}
+
+type ScrapShipResponse struct {
+	Agent       *Agent            `json:"agent"`
+	Transaction *ScrapTransaction `json:"transaction"`
+}
+
+type SellCargoRequest struct {
+	Symbol TradeSymbol `json:"symbol"`
+	// Amounts of units to sell of the selected good.
+	Units int `json:"units"`
+}
+
+type SellCargoResponse struct {
+	Agent       *Agent             `json:"agent"`
+	Cargo       *ShipCargo         `json:"cargo"`
+	Transaction *MarketTransaction `json:"transaction"`
+}
+
+type SiphonResourcesResponse struct {
+	Cargo    *ShipCargo           `json:"cargo"`
+	Cooldown *Cooldown            `json:"cooldown"`
+	Events   []ShipConditionEvent `json:"events"`
+	Siphon   *Siphon              `json:"siphon"`
+}
+
+type CreateSurveyResponse struct {
+	Cooldown *Cooldown `json:"cooldown"`
+	// Surveys created by this action.
+	Surveys []Survey `json:"surveys"`
+}
+
+type TransferCargoRequest struct {
+	// The symbol of the ship to transfer to.
+	ShipSymbol  string      `json:"shipSymbol"`
+	TradeSymbol TradeSymbol `json:"tradeSymbol"`
+	// Amount of units to transfer.
+	Units int `json:"units"`
+}
+
+type TransferCargoResponse struct {
+	Cargo *ShipCargo `json:"cargo"`
+}
+
+type WarpShipRequest struct {
+	// The target destination.
+	WaypointSymbol string `json:"waypointSymbol"`
+}
+
+type WarpShipResponse struct {
+	Fuel *ShipFuel `json:"fuel"`
+	Nav  *ShipNav  `json:"nav"`
+}
+
+type RegisterRequest struct {
+	// Your email address. This is used if you reserved your call sign between resets.
+	Email   string        `json:"email,omitempty"`
+	Faction FactionSymbol `json:"faction"`
+	// Your desired agent symbol. This will be a unique name used to represent your agent, and will be the prefix for your ships.
+	Symbol string `json:"symbol"`
+}
+
+type RegisterResponse struct {
+	Agent    *Agent    `json:"agent"`
+	Contract *Contract `json:"contract"`
+	Faction  *Faction  `json:"faction"`
+	Ship     *Ship     `json:"ship"`
+	// A Bearer token for accessing secured API endpoints.
+	Token string `json:"token"`
+}
+
+type GetSystemsResponse struct {
+	Data []System `json:"data"`
+	Meta *Meta    `json:"meta"`
+}
+
+type GetSystemWaypointsResponse struct {
+	Data []Waypoint `json:"data"`
+	Meta *Meta      `json:"meta"`
+}
+
+type SupplyConstructionRequest struct {
+	// Symbol of the ship to use.
+	ShipSymbol string `json:"shipSymbol"`
+	// The symbol of the good to supply.
+	TradeSymbol string `json:"tradeSymbol"`
+	// Amount of units to supply.
+	Units int `json:"units"`
+}
+
+type SupplyConstructionResponse struct {
+	Cargo        *ShipCargo    `json:"cargo"`
+	Construction *Construction `json:"construction"`
+}
+
+// Type of contract.
+type ContractType string
+
+const (
+	CONTRACT_TYPE_PROCUREMENT ContractType = "PROCUREMENT"
+	CONTRACT_TYPE_TRANSPORT   ContractType = "TRANSPORT"
+	CONTRACT_TYPE_SHUTTLE     ContractType = "SHUTTLE"
+)
+
+var knownContractTypes = []ContractType{
+	CONTRACT_TYPE_PROCUREMENT,
+	CONTRACT_TYPE_TRANSPORT,
+	CONTRACT_TYPE_SHUTTLE,
+}
+
+// Every ContractType this package knows about.
+func AllContractTypes() []ContractType {
+	return append([]ContractType(nil), knownContractTypes...)
+}
+
+func (self ContractType) IsKnown() bool {
+	for _, value := range knownContractTypes {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self ContractType) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a ContractType.", UnknownEnumError, string(self))
+}
+
+// The type of trade good (export, import, or exchange).
+type TradeGoodType string
+
+const (
+	TRADE_GOOD_TYPE_EXPORT   TradeGoodType = "EXPORT"
+	TRADE_GOOD_TYPE_IMPORT   TradeGoodType = "IMPORT"
+	TRADE_GOOD_TYPE_EXCHANGE TradeGoodType = "EXCHANGE"
+)
+
+var knownTradeGoodTypes = []TradeGoodType{
+	TRADE_GOOD_TYPE_EXPORT,
+	TRADE_GOOD_TYPE_IMPORT,
+	TRADE_GOOD_TYPE_EXCHANGE,
+}
+
+// Every TradeGoodType this package knows about.
+func AllTradeGoodTypes() []TradeGoodType {
+	return append([]TradeGoodType(nil), knownTradeGoodTypes...)
+}
+
+func (self TradeGoodType) IsKnown() bool {
+	for _, value := range knownTradeGoodTypes {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self TradeGoodType) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a TradeGoodType.", UnknownEnumError, string(self))
+}
+
+// The type of transaction.
+type TransactionType string
+
+const (
+	TRANSACTION_TYPE_PURCHASE TransactionType = "PURCHASE"
+	TRANSACTION_TYPE_SELL     TransactionType = "SELL"
+)
+
+var knownTransactionTypes = []TransactionType{
+	TRANSACTION_TYPE_PURCHASE,
+	TRANSACTION_TYPE_SELL,
+}
+
+// Every TransactionType this package knows about.
+func AllTransactionTypes() []TransactionType {
+	return append([]TransactionType(nil), knownTransactionTypes...)
+}
+
+func (self TransactionType) IsKnown() bool {
+	for _, value := range knownTransactionTypes {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self TransactionType) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a TransactionType.", UnknownEnumError, string(self))
+}
+
+// The engine of the ship.
+type ScannedShipEngine struct {
+	// The symbol of the engine.
+	Symbol string `json:"symbol"`
+}
+
+// The frame of the ship.
+type ScannedShipFrame struct {
+	// The symbol of the frame.
+	Symbol string `json:"symbol"`
+}
+
+// A mount on the ship.
+type ScannedShipMountsItem struct {
+	// The symbol of the mount.
+	Symbol string `json:"symbol"`
+}
+
+// The reactor of the ship.
+type ScannedShipReactor struct {
+	// The symbol of the reactor.
+	Symbol string `json:"symbol"`
+}
+
+type ShipConditionEventComponent string
+
+const (
+	SHIP_CONDITION_EVENT_COMPONENT_FRAME   ShipConditionEventComponent = "FRAME"
+	SHIP_CONDITION_EVENT_COMPONENT_REACTOR ShipConditionEventComponent = "REACTOR"
+	SHIP_CONDITION_EVENT_COMPONENT_ENGINE  ShipConditionEventComponent = "ENGINE"
+)
+
+var knownShipConditionEventComponents = []ShipConditionEventComponent{
+	SHIP_CONDITION_EVENT_COMPONENT_FRAME,
+	SHIP_CONDITION_EVENT_COMPONENT_REACTOR,
+	SHIP_CONDITION_EVENT_COMPONENT_ENGINE,
+}
+
+// Every ShipConditionEventComponent this package knows about.
+func AllShipConditionEventComponents() []ShipConditionEventComponent {
+	return append([]ShipConditionEventComponent(nil), knownShipConditionEventComponents...)
+}
+
+func (self ShipConditionEventComponent) IsKnown() bool {
+	for _, value := range knownShipConditionEventComponents {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self ShipConditionEventComponent) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a ShipConditionEventComponent.", UnknownEnumError, string(self))
+}
+
+type ShipConditionEventSymbol string
+
+const (
+	SHIP_CONDITION_EVENT_SYMBOL_REACTOR_OVERLOAD                   ShipConditionEventSymbol = "REACTOR_OVERLOAD"
+	SHIP_CONDITION_EVENT_SYMBOL_ENERGY_SPIKE_FROM_MINERAL          ShipConditionEventSymbol = "ENERGY_SPIKE_FROM_MINERAL"
+	SHIP_CONDITION_EVENT_SYMBOL_SOLAR_FLARE_INTERFERENCE           ShipConditionEventSymbol = "SOLAR_FLARE_INTERFERENCE"
+	SHIP_CONDITION_EVENT_SYMBOL_COOLANT_LEAK                       ShipConditionEventSymbol = "COOLANT_LEAK"
+	SHIP_CONDITION_EVENT_SYMBOL_POWER_DISTRIBUTION_FLUCTUATION     ShipConditionEventSymbol = "POWER_DISTRIBUTION_FLUCTUATION"
+	SHIP_CONDITION_EVENT_SYMBOL_MAGNETIC_FIELD_DISRUPTION          ShipConditionEventSymbol = "MAGNETIC_FIELD_DISRUPTION"
+	SHIP_CONDITION_EVENT_SYMBOL_HULL_MICROMETEORITE_STRIKES        ShipConditionEventSymbol = "HULL_MICROMETEORITE_STRIKES"
+	SHIP_CONDITION_EVENT_SYMBOL_STRUCTURAL_STRESS_FRACTURES        ShipConditionEventSymbol = "STRUCTURAL_STRESS_FRACTURES"
+	SHIP_CONDITION_EVENT_SYMBOL_CORROSIVE_MINERAL_CONTAMINATION    ShipConditionEventSymbol = "CORROSIVE_MINERAL_CONTAMINATION"
+	SHIP_CONDITION_EVENT_SYMBOL_THERMAL_EXPANSION_MISMATCH         ShipConditionEventSymbol = "THERMAL_EXPANSION_MISMATCH"
+	SHIP_CONDITION_EVENT_SYMBOL_VIBRATION_DAMAGE_FROM_DRILLING     ShipConditionEventSymbol = "VIBRATION_DAMAGE_FROM_DRILLING"
+	SHIP_CONDITION_EVENT_SYMBOL_ELECTROMAGNETIC_FIELD_INTERFERENCE ShipConditionEventSymbol = "ELECTROMAGNETIC_FIELD_INTERFERENCE"
+	SHIP_CONDITION_EVENT_SYMBOL_IMPACT_WITH_EXTRACTED_DEBRIS       ShipConditionEventSymbol = "IMPACT_WITH_EXTRACTED_DEBRIS"
+	SHIP_CONDITION_EVENT_SYMBOL_FUEL_EFFICIENCY_DEGRADATION        ShipConditionEventSymbol = "FUEL_EFFICIENCY_DEGRADATION"
+	SHIP_CONDITION_EVENT_SYMBOL_COOLANT_SYSTEM_AGEING              ShipConditionEventSymbol = "COOLANT_SYSTEM_AGEING"
+	SHIP_CONDITION_EVENT_SYMBOL_DUST_MICROABRASIONS                ShipConditionEventSymbol = "DUST_MICROABRASIONS"
+	SHIP_CONDITION_EVENT_SYMBOL_THRUSTER_NOZZLE_WEAR               ShipConditionEventSymbol = "THRUSTER_NOZZLE_WEAR"
+	SHIP_CONDITION_EVENT_SYMBOL_EXHAUST_PORT_CLOGGING              ShipConditionEventSymbol = "EXHAUST_PORT_CLOGGING"
+	SHIP_CONDITION_EVENT_SYMBOL_BEARING_LUBRICATION_FADE           ShipConditionEventSymbol = "BEARING_LUBRICATION_FADE"
+	SHIP_CONDITION_EVENT_SYMBOL_SENSOR_CALIBRATION_DRIFT           ShipConditionEventSymbol = "SENSOR_CALIBRATION_DRIFT"
+	SHIP_CONDITION_EVENT_SYMBOL_HULL_MICROMETEORITE_DAMAGE         ShipConditionEventSymbol = "HULL_MICROMETEORITE_DAMAGE"
+	SHIP_CONDITION_EVENT_SYMBOL_SPACE_DEBRIS_COLLISION             ShipConditionEventSymbol = "SPACE_DEBRIS_COLLISION"
+	SHIP_CONDITION_EVENT_SYMBOL_THERMAL_STRESS                     ShipConditionEventSymbol = "THERMAL_STRESS"
+	SHIP_CONDITION_EVENT_SYMBOL_VIBRATION_OVERLOAD                 ShipConditionEventSymbol = "VIBRATION_OVERLOAD"
+	SHIP_CONDITION_EVENT_SYMBOL_PRESSURE_DIFFERENTIAL_STRESS       ShipConditionEventSymbol = "PRESSURE_DIFFERENTIAL_STRESS"
+	SHIP_CONDITION_EVENT_SYMBOL_ELECTROMAGNETIC_SURGE_EFFECTS      ShipConditionEventSymbol = "ELECTROMAGNETIC_SURGE_EFFECTS"
+	SHIP_CONDITION_EVENT_SYMBOL_ATMOSPHERIC_ENTRY_HEAT             ShipConditionEventSymbol = "ATMOSPHERIC_ENTRY_HEAT"
+)
+
+var knownShipConditionEventSymbols = []ShipConditionEventSymbol{
+	SHIP_CONDITION_EVENT_SYMBOL_REACTOR_OVERLOAD,
+	SHIP_CONDITION_EVENT_SYMBOL_ENERGY_SPIKE_FROM_MINERAL,
+	SHIP_CONDITION_EVENT_SYMBOL_SOLAR_FLARE_INTERFERENCE,
+	SHIP_CONDITION_EVENT_SYMBOL_COOLANT_LEAK,
+	SHIP_CONDITION_EVENT_SYMBOL_POWER_DISTRIBUTION_FLUCTUATION,
+	SHIP_CONDITION_EVENT_SYMBOL_MAGNETIC_FIELD_DISRUPTION,
+	SHIP_CONDITION_EVENT_SYMBOL_HULL_MICROMETEORITE_STRIKES,
+	SHIP_CONDITION_EVENT_SYMBOL_STRUCTURAL_STRESS_FRACTURES,
+	SHIP_CONDITION_EVENT_SYMBOL_CORROSIVE_MINERAL_CONTAMINATION,
+	SHIP_CONDITION_EVENT_SYMBOL_THERMAL_EXPANSION_MISMATCH,
+	SHIP_CONDITION_EVENT_SYMBOL_VIBRATION_DAMAGE_FROM_DRILLING,
+	SHIP_CONDITION_EVENT_SYMBOL_ELECTROMAGNETIC_FIELD_INTERFERENCE,
+	SHIP_CONDITION_EVENT_SYMBOL_IMPACT_WITH_EXTRACTED_DEBRIS,
+	SHIP_CONDITION_EVENT_SYMBOL_FUEL_EFFICIENCY_DEGRADATION,
+	SHIP_CONDITION_EVENT_SYMBOL_COOLANT_SYSTEM_AGEING,
+	SHIP_CONDITION_EVENT_SYMBOL_DUST_MICROABRASIONS,
+	SHIP_CONDITION_EVENT_SYMBOL_THRUSTER_NOZZLE_WEAR,
+	SHIP_CONDITION_EVENT_SYMBOL_EXHAUST_PORT_CLOGGING,
+	SHIP_CONDITION_EVENT_SYMBOL_BEARING_LUBRICATION_FADE,
+	SHIP_CONDITION_EVENT_SYMBOL_SENSOR_CALIBRATION_DRIFT,
+	SHIP_CONDITION_EVENT_SYMBOL_HULL_MICROMETEORITE_DAMAGE,
+	SHIP_CONDITION_EVENT_SYMBOL_SPACE_DEBRIS_COLLISION,
+	SHIP_CONDITION_EVENT_SYMBOL_THERMAL_STRESS,
+	SHIP_CONDITION_EVENT_SYMBOL_VIBRATION_OVERLOAD,
+	SHIP_CONDITION_EVENT_SYMBOL_PRESSURE_DIFFERENTIAL_STRESS,
+	SHIP_CONDITION_EVENT_SYMBOL_ELECTROMAGNETIC_SURGE_EFFECTS,
+	SHIP_CONDITION_EVENT_SYMBOL_ATMOSPHERIC_ENTRY_HEAT,
+}
+
+// Every ShipConditionEventSymbol this package knows about.
+func AllShipConditionEventSymbols() []ShipConditionEventSymbol {
+	return append([]ShipConditionEventSymbol(nil), knownShipConditionEventSymbols...)
+}
+
+func (self ShipConditionEventSymbol) IsKnown() bool {
+	for _, value := range knownShipConditionEventSymbols {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self ShipConditionEventSymbol) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a ShipConditionEventSymbol.", UnknownEnumError, string(self))
+}
+
+// The rotation of crew shifts. A stricter shift improves the ship's performance. A more relaxed shift improves the crew's morale.
+type ShipCrewRotation string
+
+const (
+	CREW_ROTATION_STRICT  ShipCrewRotation = "STRICT"
+	CREW_ROTATION_RELAXED ShipCrewRotation = "RELAXED"
+)
+
+var knownShipCrewRotations = []ShipCrewRotation{
+	CREW_ROTATION_STRICT,
+	CREW_ROTATION_RELAXED,
+}
+
+// Every ShipCrewRotation this package knows about.
+func AllShipCrewRotations() []ShipCrewRotation {
+	return append([]ShipCrewRotation(nil), knownShipCrewRotations...)
+}
+
+func (self ShipCrewRotation) IsKnown() bool {
+	for _, value := range knownShipCrewRotations {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self ShipCrewRotation) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a ShipCrewRotation.", UnknownEnumError, string(self))
+}
+
+// The symbol of the engine.
+type ShipEngineSymbol string
+
+const (
+	ENGINE_IMPULSE_DRIVE_I ShipEngineSymbol = "ENGINE_IMPULSE_DRIVE_I"
+	ENGINE_ION_DRIVE_I     ShipEngineSymbol = "ENGINE_ION_DRIVE_I"
+	ENGINE_ION_DRIVE_II    ShipEngineSymbol = "ENGINE_ION_DRIVE_II"
+	ENGINE_HYPER_DRIVE_I   ShipEngineSymbol = "ENGINE_HYPER_DRIVE_I"
+)
+
+var knownShipEngineSymbols = []ShipEngineSymbol{
+	ENGINE_IMPULSE_DRIVE_I,
+	ENGINE_ION_DRIVE_I,
+	ENGINE_ION_DRIVE_II,
+	ENGINE_HYPER_DRIVE_I,
+}
+
+// Every ShipEngineSymbol this package knows about.
+func AllShipEngineSymbols() []ShipEngineSymbol {
+	return append([]ShipEngineSymbol(nil), knownShipEngineSymbols...)
+}
+
+func (self ShipEngineSymbol) IsKnown() bool {
+	for _, value := range knownShipEngineSymbols {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self ShipEngineSymbol) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a ShipEngineSymbol.", UnknownEnumError, string(self))
+}
+
+// Symbol of the frame.
+type ShipFrameSymbol string
+
+const (
+	FRAME_PROBE           ShipFrameSymbol = "FRAME_PROBE"
+	FRAME_DRONE           ShipFrameSymbol = "FRAME_DRONE"
+	FRAME_INTERCEPTOR     ShipFrameSymbol = "FRAME_INTERCEPTOR"
+	FRAME_RACER           ShipFrameSymbol = "FRAME_RACER"
+	FRAME_FIGHTER         ShipFrameSymbol = "FRAME_FIGHTER"
+	FRAME_FRIGATE         ShipFrameSymbol = "FRAME_FRIGATE"
+	FRAME_SHUTTLE         ShipFrameSymbol = "FRAME_SHUTTLE"
+	FRAME_EXPLORER        ShipFrameSymbol = "FRAME_EXPLORER"
+	FRAME_MINER           ShipFrameSymbol = "FRAME_MINER"
+	FRAME_LIGHT_FREIGHTER ShipFrameSymbol = "FRAME_LIGHT_FREIGHTER"
+	FRAME_HEAVY_FREIGHTER ShipFrameSymbol = "FRAME_HEAVY_FREIGHTER"
+	FRAME_TRANSPORT       ShipFrameSymbol = "FRAME_TRANSPORT"
+	FRAME_DESTROYER       ShipFrameSymbol = "FRAME_DESTROYER"
+	FRAME_CRUISER         ShipFrameSymbol = "FRAME_CRUISER"
+	FRAME_CARRIER         ShipFrameSymbol = "FRAME_CARRIER"
+)
+
+var knownShipFrameSymbols = []ShipFrameSymbol{
+	FRAME_PROBE,
+	FRAME_DRONE,
+	FRAME_INTERCEPTOR,
+	FRAME_RACER,
+	FRAME_FIGHTER,
+	FRAME_FRIGATE,
+	FRAME_SHUTTLE,
+	FRAME_EXPLORER,
+	FRAME_MINER,
+	FRAME_LIGHT_FREIGHTER,
+	FRAME_HEAVY_FREIGHTER,
+	FRAME_TRANSPORT,
+	FRAME_DESTROYER,
+	FRAME_CRUISER,
+	FRAME_CARRIER,
+}
+
+// Every ShipFrameSymbol this package knows about.
+func AllShipFrameSymbols() []ShipFrameSymbol {
+	return append([]ShipFrameSymbol(nil), knownShipFrameSymbols...)
+}
+
+func (self ShipFrameSymbol) IsKnown() bool {
+	for _, value := range knownShipFrameSymbols {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self ShipFrameSymbol) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a ShipFrameSymbol.", UnknownEnumError, string(self))
+}
+
+// An object that only shows up when an action has consumed fuel in the process. Shows the fuel consumption data.
+type ShipFuelConsumed struct {
+	// The amount of fuel consumed by the most recent transit or action.
+	Amount int `json:"amount"`
+	// The time at which the fuel was consumed.
+	Timestamp string `json:"timestamp"`
+}
+
+// The symbol of the module.
+type ShipModuleSymbol string
+
+const (
+	MODULE_MINERAL_PROCESSOR_I ShipModuleSymbol = "MODULE_MINERAL_PROCESSOR_I"
+	MODULE_GAS_PROCESSOR_I     ShipModuleSymbol = "MODULE_GAS_PROCESSOR_I"
+	MODULE_CARGO_HOLD_I        ShipModuleSymbol = "MODULE_CARGO_HOLD_I"
+	MODULE_CARGO_HOLD_II       ShipModuleSymbol = "MODULE_CARGO_HOLD_II"
+	MODULE_CARGO_HOLD_III      ShipModuleSymbol = "MODULE_CARGO_HOLD_III"
+	MODULE_CREW_QUARTERS_I     ShipModuleSymbol = "MODULE_CREW_QUARTERS_I"
+	MODULE_ENVOY_QUARTERS_I    ShipModuleSymbol = "MODULE_ENVOY_QUARTERS_I"
+	MODULE_PASSENGER_CABIN_I   ShipModuleSymbol = "MODULE_PASSENGER_CABIN_I"
+	MODULE_MICRO_REFINERY_I    ShipModuleSymbol = "MODULE_MICRO_REFINERY_I"
+	MODULE_ORE_REFINERY_I      ShipModuleSymbol = "MODULE_ORE_REFINERY_I"
+	MODULE_FUEL_REFINERY_I     ShipModuleSymbol = "MODULE_FUEL_REFINERY_I"
+	MODULE_SCIENCE_LAB_I       ShipModuleSymbol = "MODULE_SCIENCE_LAB_I"
+	MODULE_JUMP_DRIVE_I        ShipModuleSymbol = "MODULE_JUMP_DRIVE_I"
+	MODULE_JUMP_DRIVE_II       ShipModuleSymbol = "MODULE_JUMP_DRIVE_II"
+	MODULE_JUMP_DRIVE_III      ShipModuleSymbol = "MODULE_JUMP_DRIVE_III"
+	MODULE_WARP_DRIVE_I        ShipModuleSymbol = "MODULE_WARP_DRIVE_I"
+	MODULE_WARP_DRIVE_II       ShipModuleSymbol = "MODULE_WARP_DRIVE_II"
+	MODULE_WARP_DRIVE_III      ShipModuleSymbol = "MODULE_WARP_DRIVE_III"
+	MODULE_SHIELD_GENERATOR_I  ShipModuleSymbol = "MODULE_SHIELD_GENERATOR_I"
+	MODULE_SHIELD_GENERATOR_II ShipModuleSymbol = "MODULE_SHIELD_GENERATOR_II"
+)
+
+var knownShipModuleSymbols = []ShipModuleSymbol{
+	MODULE_MINERAL_PROCESSOR_I,
+	MODULE_GAS_PROCESSOR_I,
+	MODULE_CARGO_HOLD_I,
+	MODULE_CARGO_HOLD_II,
+	MODULE_CARGO_HOLD_III,
+	MODULE_CREW_QUARTERS_I,
+	MODULE_ENVOY_QUARTERS_I,
+	MODULE_PASSENGER_CABIN_I,
+	MODULE_MICRO_REFINERY_I,
+	MODULE_ORE_REFINERY_I,
+	MODULE_FUEL_REFINERY_I,
+	MODULE_SCIENCE_LAB_I,
+	MODULE_JUMP_DRIVE_I,
+	MODULE_JUMP_DRIVE_II,
+	MODULE_JUMP_DRIVE_III,
+	MODULE_WARP_DRIVE_I,
+	MODULE_WARP_DRIVE_II,
+	MODULE_WARP_DRIVE_III,
+	MODULE_SHIELD_GENERATOR_I,
+	MODULE_SHIELD_GENERATOR_II,
+}
+
+// Every ShipModuleSymbol this package knows about.
+func AllShipModuleSymbols() []ShipModuleSymbol {
+	return append([]ShipModuleSymbol(nil), knownShipModuleSymbols...)
+}
+
+func (self ShipModuleSymbol) IsKnown() bool {
+	for _, value := range knownShipModuleSymbols {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self ShipModuleSymbol) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a ShipModuleSymbol.", UnknownEnumError, string(self))
+}
+
+// Symbol of this mount.
+type ShipMountSymbol string
+
+const (
+	MOUNT_GAS_SIPHON_I       ShipMountSymbol = "MOUNT_GAS_SIPHON_I"
+	MOUNT_GAS_SIPHON_II      ShipMountSymbol = "MOUNT_GAS_SIPHON_II"
+	MOUNT_GAS_SIPHON_III     ShipMountSymbol = "MOUNT_GAS_SIPHON_III"
+	MOUNT_SURVEYOR_I         ShipMountSymbol = "MOUNT_SURVEYOR_I"
+	MOUNT_SURVEYOR_II        ShipMountSymbol = "MOUNT_SURVEYOR_II"
+	MOUNT_SURVEYOR_III       ShipMountSymbol = "MOUNT_SURVEYOR_III"
+	MOUNT_SENSOR_ARRAY_I     ShipMountSymbol = "MOUNT_SENSOR_ARRAY_I"
+	MOUNT_SENSOR_ARRAY_II    ShipMountSymbol = "MOUNT_SENSOR_ARRAY_II"
+	MOUNT_SENSOR_ARRAY_III   ShipMountSymbol = "MOUNT_SENSOR_ARRAY_III"
+	MOUNT_MINING_LASER_I     ShipMountSymbol = "MOUNT_MINING_LASER_I"
+	MOUNT_MINING_LASER_II    ShipMountSymbol = "MOUNT_MINING_LASER_II"
+	MOUNT_MINING_LASER_III   ShipMountSymbol = "MOUNT_MINING_LASER_III"
+	MOUNT_LASER_CANNON_I     ShipMountSymbol = "MOUNT_LASER_CANNON_I"
+	MOUNT_MISSILE_LAUNCHER_I ShipMountSymbol = "MOUNT_MISSILE_LAUNCHER_I"
+	MOUNT_TURRET_I           ShipMountSymbol = "MOUNT_TURRET_I"
+)
+
+var knownShipMountSymbols = []ShipMountSymbol{
+	MOUNT_GAS_SIPHON_I,
+	MOUNT_GAS_SIPHON_II,
+	MOUNT_GAS_SIPHON_III,
+	MOUNT_SURVEYOR_I,
+	MOUNT_SURVEYOR_II,
+	MOUNT_SURVEYOR_III,
+	MOUNT_SENSOR_ARRAY_I,
+	MOUNT_SENSOR_ARRAY_II,
+	MOUNT_SENSOR_ARRAY_III,
+	MOUNT_MINING_LASER_I,
+	MOUNT_MINING_LASER_II,
+	MOUNT_MINING_LASER_III,
+	MOUNT_LASER_CANNON_I,
+	MOUNT_MISSILE_LAUNCHER_I,
+	MOUNT_TURRET_I,
+}
+
+// Every ShipMountSymbol this package knows about.
+func AllShipMountSymbols() []ShipMountSymbol {
+	return append([]ShipMountSymbol(nil), knownShipMountSymbols...)
+}
+
+func (self ShipMountSymbol) IsKnown() bool {
+	for _, value := range knownShipMountSymbols {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self ShipMountSymbol) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a ShipMountSymbol.", UnknownEnumError, string(self))
+}
+
+// Symbol of the reactor.
+type ShipReactorSymbol string
+
+const (
+	REACTOR_SOLAR_I      ShipReactorSymbol = "REACTOR_SOLAR_I"
+	REACTOR_FUSION_I     ShipReactorSymbol = "REACTOR_FUSION_I"
+	REACTOR_FISSION_I    ShipReactorSymbol = "REACTOR_FISSION_I"
+	REACTOR_CHEMICAL_I   ShipReactorSymbol = "REACTOR_CHEMICAL_I"
+	REACTOR_ANTIMATTER_I ShipReactorSymbol = "REACTOR_ANTIMATTER_I"
+)
+
+var knownShipReactorSymbols = []ShipReactorSymbol{
+	REACTOR_SOLAR_I,
+	REACTOR_FUSION_I,
+	REACTOR_FISSION_I,
+	REACTOR_CHEMICAL_I,
+	REACTOR_ANTIMATTER_I,
+}
+
+// Every ShipReactorSymbol this package knows about.
+func AllShipReactorSymbols() []ShipReactorSymbol {
+	return append([]ShipReactorSymbol(nil), knownShipReactorSymbols...)
+}
+
+func (self ShipReactorSymbol) IsKnown() bool {
+	for _, value := range knownShipReactorSymbols {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self ShipReactorSymbol) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a ShipReactorSymbol.", UnknownEnumError, string(self))
+}
+
+type ShipyardShipTypesItem struct {
+	Type ShipType `json:"type"`
+}
+
+type ShipyardShipCrew struct {
+	Capacity int `json:"capacity"`
+	Required int `json:"required"`
+}
+
+// The size of the deposit. This value indicates how much can be extracted from the survey before it is exhausted.
+type SurveySize string
+
+const (
+	SURVEY_SIZE_SMALL    SurveySize = "SMALL"
+	SURVEY_SIZE_MODERATE SurveySize = "MODERATE"
+	SURVEY_SIZE_LARGE    SurveySize = "LARGE"
+)
+
+var knownSurveySizes = []SurveySize{
+	SURVEY_SIZE_SMALL,
+	SURVEY_SIZE_MODERATE,
+	SURVEY_SIZE_LARGE,
+}
+
+// Every SurveySize this package knows about.
+func AllSurveySizes() []SurveySize {
+	return append([]SurveySize(nil), knownSurveySizes...)
+}
+
+func (self SurveySize) IsKnown() bool {
+	for _, value := range knownSurveySizes {
+		if self == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self SurveySize) Validate() error {
+	if self.IsKnown() {
+		return nil
+	}
+
+	return fmt.Errorf("%w %q is not a SurveySize.", UnknownEnumError, string(self))
+}
+
+type GetStatusResponseAnnouncementsItem struct {
+	Body  string `json:"body"`
+	Title string `json:"title"`
+}
+
+type GetStatusResponseLeaderboards struct {
+	// Top agents with the most credits.
+	MostCredits []GetStatusResponseLeaderboardsMostCreditsItem `json:"mostCredits"`
+	// Top agents with the most charted submitted.
+	MostSubmittedCharts []GetStatusResponseLeaderboardsMostSubmittedChartsItem `json:"mostSubmittedCharts"`
+}
+
+type GetStatusResponseLinksItem struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+type GetStatusResponseServerResets struct {
+	// How often we intend to reset the game server.
+	Frequency string `json:"frequency"`
+	// The date and time when the game server will reset.
+	Next string `json:"next"`
+}
+
+type GetStatusResponseStats struct {
+	// Number of registered agents in the game.
+	Agents int `json:"agents"`
+	// Total number of ships in the game.
+	Ships int `json:"ships"`
+	// Total number of systems in the game.
+	Systems int `json:"systems"`
+	// Total number of waypoints in the game.
+	Waypoints int `json:"waypoints"`
+}
+
+type GetMyFactionsResponseDataItem struct {
+	Name       string `json:"name"`
+	Reputation int    `json:"reputation"`
+	Symbol     string `json:"symbol"`
+}
+
+type ShipRefineResponseConsumedItem struct {
+	// Symbol of the good.
+	TradeSymbol string `json:"tradeSymbol"`
+	// Amount of units of the good.
+	Units int `json:"units"`
+}
+
+type ShipRefineResponseProducedItem struct {
+	// Symbol of the good.
+	TradeSymbol string `json:"tradeSymbol"`
+	// Amount of units of the good.
+	Units int `json:"units"`
+}
+
+type GetStatusResponseLeaderboardsMostCreditsItem struct {
+	// Symbol of the agent.
+	AgentSymbol string `json:"agentSymbol"`
+	// Amount of credits.
+	Credits int `json:"credits"`
+}
+
+type GetStatusResponseLeaderboardsMostSubmittedChartsItem struct {
+	// Symbol of the agent.
+	AgentSymbol string `json:"agentSymbol"`
+	// Amount of charts done by the agent.
+	ChartCount int `json:"chartCount"`
+}
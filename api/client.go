@@ -0,0 +1,160 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+const BASE_URL = "https://api.spacetraders.io/v2"
+
+// The error object spacetraders.io sends back instead of data.
+type Error struct {
+	Code    int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf(
+		"Code: %d\tMessage: %s",
+		e.Code,
+		e.Message,
+	)
+}
+
+var NoContentError = fmt.Errorf("No content in response.")
+
+// The enum types are plain strings, so values the server adds after a reset
+// still decode. Call Validate on input from people to catch typos.
+var UnknownEnumError = fmt.Errorf("Unknown enum value.")
+
+// Low level access to the endpoints in the OpenAPI document.
+// Token is sent as a Bearer token if it isn't empty.
+// Do sends a request and returns the body of the response.
+// Leave it nil to use DefaultDo.
+type Client struct {
+	BaseURL string
+	Token   string
+	Do      func(req *http.Request) ([]byte, error)
+}
+
+func NewClient(token string) *Client {
+	return &Client{BaseURL: BASE_URL, Token: token}
+}
+
+// What a Client with no Do sends requests with.
+// space_traders_api sets it to its SendRequest, so every Client shares its rate limiter.
+// Otherwise it's a plain http.DefaultClient request.
+var DefaultDo = defaultDo
+
+func defaultDo(req *http.Request) ([]byte, error) {
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || 299 < resp.StatusCode {
+		apiErr := new(struct{ Error *Error })
+		if json.Unmarshal(body, apiErr) == nil && apiErr.Error != nil {
+			return nil, apiErr.Error
+		}
+		return nil, fmt.Errorf("Non-200 status from request. %s", resp.Status)
+	}
+
+	return body, nil
+}
+
+// What every generated endpoint calls.
+// If unwrap is set the response is expected to look like {"data": result}.
+func (self *Client) call(
+	method string,
+	path string,
+	query url.Values,
+	body any,
+	result any,
+	unwrap bool,
+) error {
+	errPrefix := method + " " + path + "."
+	bodyJSON := []byte{}
+
+	if body != nil {
+		var err error
+		bodyJSON, err = json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("%s Encoding body JSON. %w", errPrefix, err)
+		}
+	}
+
+	baseURL := self.BaseURL
+	if baseURL == "" {
+		baseURL = BASE_URL
+	}
+	target := baseURL + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequest(method, target, bytes.NewReader(bodyJSON))
+	if err != nil {
+		return fmt.Errorf("%s Creating request. %w", errPrefix, err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if self.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+self.Token)
+	}
+
+	do := self.Do
+	if do == nil {
+		do = DefaultDo
+	}
+
+	buf, err := do(req)
+	if err != nil {
+		return fmt.Errorf("%s Sending request.\n%w", errPrefix, err)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	if !unwrap {
+		err = json.Unmarshal(buf, result)
+		if err != nil {
+			return fmt.Errorf("%s Unmarshaling response.\n%w", errPrefix, err)
+		}
+		return nil
+	}
+
+	respObject := new(struct {
+		Data  json.RawMessage
+		Error *Error
+	})
+	err = json.Unmarshal(buf, respObject)
+	if err != nil {
+		return fmt.Errorf("%s Unmarshaling response.\n%w", errPrefix, err)
+	}
+	if respObject.Error != nil {
+		return fmt.Errorf("%s spacetraders.io error. %w", errPrefix, respObject.Error)
+	}
+	if len(respObject.Data) == 0 || string(respObject.Data) == "null" {
+		return fmt.Errorf("%s %w", errPrefix, NoContentError)
+	}
+
+	err = json.Unmarshal(respObject.Data, result)
+	if err != nil {
+		return fmt.Errorf("%s Unmarshaling data.\n%w", errPrefix, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,13 @@
+// Package api is generated from the SpaceTraders OpenAPI document in ../spec.
+// It has the models exactly as the document describes them, one Client method
+// per endpoint, and the few helpers in models.go.
+//
+// The document is upstream's, unmodified. Update it by replacing the file with
+// a newer download, never by editing it, then run go generate here.
+// space_traders_api aliases the models, or embeds them when it needs methods
+// of its own (Ship, Market), and enumgen re-exports the enums there, so there's
+// only one TradeSymbol.
+package api
+
+//go:generate go run ../internal/openapigen -in ../spec/SpaceTraders.json -out api_gen.go -package api
+//go:generate go run ../internal/enumgen -dir . -out ../enums_gen.go -package space_traders_api
@@ -0,0 +1,214 @@
+package api
+
+import (
+	"fmt"
+	"time"
+)
+
+// Helpers on the generated models. Anything that needs more than the model itself
+// lives in space_traders_api instead.
+
+func (self Agent) String() string {
+	return fmt.Sprintf(
+		"Agent %s\n"+
+			"\tAccount ID:\t%s\n"+
+			"\tCredits:\t%d\n"+
+			"\tHeadquarters\t%s\n"+
+			"\tShip Count:\t%d\n"+
+			"\t(Starting) Faction:\t%s\n",
+		self.Symbol,
+		self.AccountID,
+		self.Credits,
+		self.Headquarters,
+		self.ShipCount,
+		self.StartingFaction,
+	)
+}
+
+func (self Contract) String() string {
+	terms := ContractTerms{Payment: &ContractPayment{}}
+	if self.Terms != nil {
+		terms = *self.Terms
+	}
+	if terms.Payment == nil {
+		terms.Payment = &ContractPayment{}
+	}
+
+	ret := fmt.Sprintf(
+		"Contract Expires %s\n"+
+			"\t%s\n"+
+			"\t%s\n"+
+			"\tType\t%s\n"+
+			"\tTerms\n"+
+			"\t\tDeadline\t%s\n"+
+			"\t\tUp Front\t%dc\n"+
+			"\t\tFulfilled\t%dc\n"+
+			"\t\tDeliver\t%v\n",
+		self.Expiration,
+		self.ID,
+		self.FactionSymbol,
+		self.Type,
+		terms.Deadline,
+		terms.Payment.OnAccepted,
+		terms.Payment.OnFulfilled,
+		terms.Deliver,
+	)
+
+	if !self.Accepted {
+		ret += fmt.Sprintf(
+			"\tDeadlineToAccept\t%s\n",
+			self.DeadlineToAccept,
+		)
+
+	}
+
+	if self.Fulfilled {
+		ret += fmt.Sprintf("\tFULFILLED")
+	}
+
+	return ret
+}
+
+// Units of tradeSymbol still to deliver, across every delivery term.
+func (self Contract) Remaining(tradeSymbol TradeSymbol) int {
+	if self.Terms == nil {
+		return 0
+	}
+
+	remaining := 0
+	for _, deliver := range self.Terms.Deliver {
+		if TradeSymbol(deliver.TradeSymbol) == tradeSymbol {
+			remaining += deliver.UnitsRequired - deliver.UnitsFulfilled
+		}
+	}
+
+	return remaining
+}
+
+// Materials with units still to deliver. Required is left as it was,
+// Fulfilled is what's been delivered so far.
+func (self *Construction) RemainingMaterials() (remaining []ConstructionMaterial) {
+	for _, material := range self.Materials {
+		if material.Fulfilled < material.Required {
+			remaining = append(remaining, material)
+		}
+	}
+
+	return remaining
+}
+
+// When the cooldown ends. Zero time if there's no expiration.
+func (self *Cooldown) ExpiresAt() time.Time {
+	if self == nil || self.Expiration == "" {
+		return time.Time{}
+	}
+
+	expiration, err := time.Parse(time.RFC3339, self.Expiration)
+	if err != nil {
+		return time.Now().Add(time.Duration(self.RemainingSeconds) * time.Second)
+	}
+
+	return expiration
+}
+
+// How many units of tradeSymbol are in the hold.
+func (self *ShipCargo) UnitsOf(tradeSymbol TradeSymbol) int {
+	if self == nil {
+		return 0
+	}
+
+	for _, item := range self.Inventory {
+		if item.Symbol == tradeSymbol {
+			return item.Units
+		}
+	}
+
+	return 0
+}
+
+// Room left in the hold.
+func (self *ShipCargo) Free() int {
+	if self == nil {
+		return 0
+	}
+
+	return self.Capacity - self.Units
+}
+
+// When the ship gets to the end of its route. Zero time if there's no arrival.
+func (self *ShipNav) ArrivesAt() time.Time {
+	if self == nil || self.Route == nil || self.Route.Arrival == "" {
+		return time.Time{}
+	}
+
+	arrival, err := time.Parse(time.RFC3339, self.Route.Arrival)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return arrival
+}
+
+// Zero time if there's no expiration.
+func (self *Survey) ExpiresAt() time.Time {
+	expiration, err := time.Parse(time.RFC3339, self.Expiration)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return expiration
+}
+
+func (self *Survey) Expired() bool {
+	expiration := self.ExpiresAt()
+
+	return !expiration.IsZero() && time.Now().After(expiration)
+}
+
+// How many of the survey's deposits are of tradeSymbols, out of the total.
+func (self *Survey) Share(tradeSymbols []TradeSymbol) float64 {
+	if len(self.Deposits) == 0 {
+		return 0
+	}
+
+	matches := 0
+	for _, deposit := range self.Deposits {
+		for _, tradeSymbol := range tradeSymbols {
+			if TradeSymbol(deposit.Symbol) == tradeSymbol {
+				matches++
+				break
+			}
+		}
+	}
+
+	return float64(matches) / float64(len(self.Deposits))
+}
+
+func (self *Waypoint) HasTrait(traitSymbol WaypointTraitSymbol) bool {
+	for _, trait := range self.Traits {
+		if trait.Symbol == traitSymbol {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self *Waypoint) HasModifier(modifierSymbol WaypointModifierSymbol) bool {
+	for _, modifier := range self.Modifiers {
+		if modifier.Symbol == modifierSymbol {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self *Waypoint) OrbitalSymbols() []string {
+	symbols := make([]string, 0, len(self.Orbitals))
+	for _, orbital := range self.Orbitals {
+		symbols = append(symbols, orbital.Symbol)
+	}
+
+	return symbols
+}
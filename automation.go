@@ -0,0 +1,311 @@
+package space_traders_api
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+// What a Behavior wants done after a step.
+// Action is a short description of what it just did or is waiting for.
+// The runner calls Step again once WakeAt has passed, the ship has arrived if it's in transit,
+// and, if AfterCooldown is set, the ship's cooldown has expired.
+// Done stops the ship's goroutine.
+type BehaviorStep struct {
+	Action        string
+	WakeAt        time.Time
+	AfterCooldown bool
+	Done          bool
+}
+
+// One small piece of a bot's loop.
+// Step is given the runner's copy of the ship and should update it with
+// whatever the actions it takes return (nav, cargo, fuel, cooldown).
+// Returning an error makes the runner refresh the ship and try again after a delay.
+type Behavior interface {
+	Step(ctx context.Context, ship *Ship, token string) (BehaviorStep, error)
+}
+
+// Lets a plain function be used as a Behavior.
+type BehaviorFunc func(ctx context.Context, ship *Ship, token string) (BehaviorStep, error)
+
+func (self BehaviorFunc) Step(ctx context.Context, ship *Ship, token string) (BehaviorStep, error) {
+	return self(ctx, ship, token)
+}
+
+var BehaviorPanicError = fmt.Errorf("Behavior panicked.")
+
+const DEFAULT_RESTART_DELAY = 5 * time.Second
+
+// Drives one goroutine per ship, each stepping a Behavior.
+// Requests go through SendRequest, so every ship shares its rate limiter.
+// A behavior that errors or panics is restarted after RestartDelay,
+// doubling each time in a row up to a minute, with the ship fetched again through Refresh.
+type Runner struct {
+	Token        string
+	RestartDelay time.Duration
+	// Gets a fresh copy of a ship. Defaults to GetShip.
+	Refresh func(shipSymbol string, token string) (*Ship, error)
+	// Called after every step. Can be nil.
+	OnStep func(shipSymbol string, step BehaviorStep)
+	// Called with every error and panic. Can be nil.
+	OnError func(shipSymbol string, err error)
+
+	mutex   sync.Mutex
+	running map[string]*runningShip
+	wait    sync.WaitGroup
+}
+
+type runningShip struct {
+	cancel context.CancelFunc
+}
+
+func NewRunner(token string) *Runner {
+	return &Runner{
+		Token:        token,
+		RestartDelay: DEFAULT_RESTART_DELAY,
+		Refresh:      GetShip,
+		running:      make(map[string]*runningShip),
+	}
+}
+
+// Starts driving ship with behavior until ctx is done, Stop is called or the behavior is done.
+// A ship that is already running is stopped first.
+func (self *Runner) Start(ctx context.Context, ship Ship, behavior Behavior) {
+	self.Stop(ship.Symbol)
+
+	ctx, cancel := context.WithCancel(ctx)
+	running := &runningShip{cancel: cancel}
+
+	self.mutex.Lock()
+	if self.running == nil {
+		self.running = make(map[string]*runningShip)
+	}
+	self.running[ship.Symbol] = running
+	self.mutex.Unlock()
+
+	self.wait.Add(1)
+	go func() {
+		defer self.wait.Done()
+		defer cancel()
+		defer func() {
+			self.mutex.Lock()
+			if self.running[ship.Symbol] == running {
+				delete(self.running, ship.Symbol)
+			}
+			self.mutex.Unlock()
+		}()
+
+		self.drive(ctx, &ship, behavior)
+	}()
+}
+
+// Stops a ship's goroutine. Its current step is allowed to finish.
+func (self *Runner) Stop(shipSymbol string) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if running, ok := self.running[shipSymbol]; ok {
+		running.cancel()
+		delete(self.running, shipSymbol)
+	}
+}
+
+// Symbols of the ships being driven.
+func (self *Runner) Running() []string {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	symbols := []string{}
+	for symbol := range self.running {
+		symbols = append(symbols, symbol)
+	}
+
+	return symbols
+}
+
+// Blocks until every ship's goroutine has returned.
+func (self *Runner) Wait() {
+	self.wait.Wait()
+}
+
+func (self *Runner) reportError(shipSymbol string, err error) {
+	if self.OnError != nil {
+		self.OnError(shipSymbol, err)
+	}
+}
+
+// Runs one step, turning a panic into an error.
+func (self *Runner) step(
+	ctx context.Context,
+	ship *Ship,
+	behavior Behavior,
+) (step BehaviorStep, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf(
+				"%w %v\n%s",
+				BehaviorPanicError,
+				r,
+				debug.Stack(),
+			)
+		}
+	}()
+
+	return behavior.Step(ctx, ship, self.Token)
+}
+
+func (self *Runner) drive(ctx context.Context, ship *Ship, behavior Behavior) {
+	failures := 0
+
+	for ctx.Err() == nil {
+		step, err := self.step(ctx, ship, behavior)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			self.reportError(ship.Symbol, err)
+
+			failures++
+			delay := self.RestartDelay
+			if delay <= 0 {
+				delay = DEFAULT_RESTART_DELAY
+			}
+			delay <<= failures - 1
+			if delay <= 0 || delay > time.Minute {
+				delay = time.Minute
+			}
+			if sleepUntil(ctx, time.Now().Add(delay)) != nil {
+				return
+			}
+
+			refresh := self.Refresh
+			if refresh == nil {
+				refresh = GetShip
+			}
+			fresh, err := refresh(ship.Symbol, self.Token)
+			if err != nil {
+				self.reportError(ship.Symbol, err)
+			} else {
+				*ship = *fresh
+			}
+			continue
+		}
+		failures = 0
+
+		if self.OnStep != nil {
+			self.OnStep(ship.Symbol, step)
+		}
+		if step.Done {
+			return
+		}
+
+		wakeAt := step.WakeAt
+		if ship.Nav != nil && ship.Nav.Status == NAV_STATUS_IN_TRANSIT &&
+			ship.Nav.ArrivesAt().After(wakeAt) {
+			wakeAt = ship.Nav.ArrivesAt()
+		}
+		if step.AfterCooldown && ship.Cooldown.ExpiresAt().After(wakeAt) {
+			wakeAt = ship.Cooldown.ExpiresAt()
+		}
+
+		if sleepUntil(ctx, wakeAt) != nil {
+			return
+		}
+
+		settleArrival(ship)
+	}
+}
+
+// Once a ship's arrival time has passed it's in orbit at its destination.
+// Saves asking the server.
+func settleArrival(ship *Ship) {
+	if ship.Nav == nil || ship.Nav.Route == nil || ship.Nav.Status != NAV_STATUS_IN_TRANSIT {
+		return
+	}
+	if time.Now().Before(ship.Nav.ArrivesAt()) {
+		return
+	}
+
+	ship.Nav.Status = NAV_STATUS_IN_ORBIT
+	ship.Nav.WaypointSymbol = ship.Nav.Route.Destination.Symbol
+	ship.Nav.SystemSymbol = ship.Nav.Route.Destination.SystemSymbol
+}
+
+func sleepUntil(ctx context.Context, t time.Time) error {
+	wait := time.Until(t)
+	if wait <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Puts the ship in orbit if it's docked.
+func ensureOrbit(ship *Ship, token string) error {
+	if ship.Nav != nil && ship.Nav.Status == NAV_STATUS_IN_ORBIT {
+		return nil
+	}
+
+	nav, err := OrbitShip(ship.Symbol, token)
+	if err != nil {
+		return err
+	}
+	ship.Nav = nav
+
+	return nil
+}
+
+// Docks the ship if it's in orbit.
+func ensureDocked(ship *Ship, token string) error {
+	if ship.Nav != nil && ship.Nav.Status == NAV_STATUS_DOCKED {
+		return nil
+	}
+
+	nav, err := DockShip(ship.Symbol, token)
+	if err != nil {
+		return err
+	}
+	ship.Nav = nav
+
+	return nil
+}
+
+// Starts the ship towards waypointSymbol if it isn't there already.
+// Returns true once it's there. Otherwise the returned step waits for its arrival.
+func moveTo(ship *Ship, waypointSymbol string, token string) (bool, BehaviorStep, error) {
+	if ship.Nav != nil &&
+		ship.Nav.Status != NAV_STATUS_IN_TRANSIT &&
+		ship.Nav.WaypointSymbol == waypointSymbol {
+		return true, BehaviorStep{}, nil
+	}
+
+	err := ensureOrbit(ship, token)
+	if err != nil {
+		return false, BehaviorStep{}, err
+	}
+
+	nav, fuel, err := NavigateShip(ship.Symbol, waypointSymbol, token)
+	if err != nil {
+		return false, BehaviorStep{}, err
+	}
+	ship.Nav = nav
+	if fuel != nil {
+		ship.Fuel = fuel
+	}
+
+	return false, BehaviorStep{
+		Action: "navigating to " + waypointSymbol,
+		WakeAt: nav.ArrivesAt(),
+	}, nil
+}
@@ -0,0 +1,412 @@
+package space_traders_api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// Kinds of cached data. Each kind has its own TTL.
+const (
+	CACHE_SYSTEM           = "system"
+	CACHE_WAYPOINT         = "waypoint"
+	CACHE_SYSTEM_WAYPOINTS = "system-waypoints"
+	CACHE_JUMP_GATE        = "jump-gate"
+)
+
+// How often the cache checks whether the server has been reset.
+const CACHE_RESET_CHECK_INTERVAL = time.Hour
+
+// How long a FileCache waits after a change before writing the file,
+// so a burst of changes is one write.
+const FILE_CACHE_SAVE_DELAY = 5 * time.Second
+
+// Systems never change between resets.
+// Waypoints can gain or lose traits and finish construction, so they expire sooner.
+var DefaultCacheTTLs = map[string]time.Duration{
+	CACHE_SYSTEM:           7 * 24 * time.Hour,
+	CACHE_WAYPOINT:         24 * time.Hour,
+	CACHE_SYSTEM_WAYPOINTS: 24 * time.Hour,
+	CACHE_JUMP_GATE:        6 * time.Hour,
+}
+
+// Storage for static and semi-static data.
+// Get decodes a fresh entry into value and returns true,
+// or returns false if there is no entry or it has expired.
+// SetResetDate clears everything if the date differs from the stored one.
+type Cache interface {
+	Get(kind string, key string, value any) (bool, error)
+	Set(kind string, key string, value any) error
+	Delete(kind string, key string) error
+	Clear() error
+	ResetDate() string
+	SetResetDate(resetDate string) error
+}
+
+type cacheEntry struct {
+	Stored time.Time
+	Value  json.RawMessage
+}
+
+// A Cache that only lives as long as the process.
+type MemoryCache struct {
+	TTLs      map[string]time.Duration
+	mutex     sync.Mutex
+	resetDate string
+	entries   map[string]map[string]cacheEntry
+}
+
+// Pass nil for DefaultCacheTTLs.
+// Kinds missing from ttls fall back to their default.
+func NewMemoryCache(ttls map[string]time.Duration) *MemoryCache {
+	cache := &MemoryCache{
+		TTLs:    make(map[string]time.Duration),
+		entries: make(map[string]map[string]cacheEntry),
+	}
+
+	for kind, ttl := range DefaultCacheTTLs {
+		cache.TTLs[kind] = ttl
+	}
+	for kind, ttl := range ttls {
+		cache.TTLs[kind] = ttl
+	}
+
+	return cache
+}
+
+func (self *MemoryCache) Get(kind string, key string, value any) (bool, error) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	entry, ok := self.entries[kind][key]
+	if !ok {
+		return false, nil
+	}
+
+	if ttl, ok := self.TTLs[kind]; ok && time.Since(entry.Stored) > ttl {
+		delete(self.entries[kind], key)
+		return false, nil
+	}
+
+	err := json.Unmarshal(entry.Value, value)
+	if err != nil {
+		return false, fmt.Errorf(
+			"Getting %s %s from cache. Decoding JSON. %w",
+			kind,
+			key,
+			err,
+		)
+	}
+
+	return true, nil
+}
+
+func (self *MemoryCache) Set(kind string, key string, value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf(
+			"Caching %s %s. Encoding JSON. %w",
+			kind,
+			key,
+			err,
+		)
+	}
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if self.entries[kind] == nil {
+		self.entries[kind] = make(map[string]cacheEntry)
+	}
+	self.entries[kind][key] = cacheEntry{Stored: time.Now(), Value: data}
+
+	return nil
+}
+
+func (self *MemoryCache) Delete(kind string, key string) error {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	delete(self.entries[kind], key)
+
+	return nil
+}
+
+func (self *MemoryCache) Clear() error {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.entries = make(map[string]map[string]cacheEntry)
+
+	return nil
+}
+
+func (self *MemoryCache) ResetDate() string {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	return self.resetDate
+}
+
+func (self *MemoryCache) SetResetDate(resetDate string) error {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if self.resetDate != resetDate {
+		self.entries = make(map[string]map[string]cacheEntry)
+		self.resetDate = resetDate
+	}
+
+	return nil
+}
+
+// A MemoryCache that writes itself to a JSON file, so it survives restarts.
+// Changes are written SaveDelay after the first one, all at once.
+// Call Flush before exiting to write anything still waiting.
+type FileCache struct {
+	*MemoryCache
+	Filename string
+	// Default FILE_CACHE_SAVE_DELAY. Negative writes on every change.
+	SaveDelay time.Duration
+
+	saveMutex sync.Mutex
+	saveTimer *time.Timer
+}
+
+type cacheFile struct {
+	ResetDate string
+	Entries   map[string]map[string]cacheEntry
+}
+
+// Loads the cache from filename if it exists.
+// Pass nil ttls for DefaultCacheTTLs.
+func NewFileCache(filename string, ttls map[string]time.Duration) (*FileCache, error) {
+	errPrefix := "Loading file cache."
+	cache := &FileCache{
+		MemoryCache: NewMemoryCache(ttls),
+		Filename:    filename,
+	}
+	contents := new(cacheFile)
+
+	fileData, err := os.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return cache, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Reading cache file %s %w",
+			errPrefix,
+			filename,
+			err,
+		)
+	}
+
+	err = json.Unmarshal(fileData, contents)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Decoding JSON from %s %w",
+			errPrefix,
+			filename,
+			err,
+		)
+	}
+
+	cache.resetDate = contents.ResetDate
+	if contents.Entries != nil {
+		cache.entries = contents.Entries
+	}
+
+	return cache, nil
+}
+
+// Writes to a temporary file first so a crash can't leave half a cache behind.
+func (self *FileCache) save() error {
+	errPrefix := "Saving file cache."
+
+	self.mutex.Lock()
+	fileData, err := json.Marshal(cacheFile{
+		ResetDate: self.resetDate,
+		Entries:   self.entries,
+	})
+	self.mutex.Unlock()
+	if err != nil {
+		return fmt.Errorf(
+			"%s Encoding JSON. %w",
+			errPrefix,
+			err,
+		)
+	}
+
+	err = os.WriteFile(self.Filename+".tmp", fileData, fs.ModePerm)
+	if err != nil {
+		return fmt.Errorf(
+			"%s Writing cache file %s %w",
+			errPrefix,
+			self.Filename+".tmp",
+			err,
+		)
+	}
+
+	err = os.Rename(self.Filename+".tmp", self.Filename)
+	if err != nil {
+		return fmt.Errorf(
+			"%s Replacing cache file %s %w",
+			errPrefix,
+			self.Filename,
+			err,
+		)
+	}
+
+	return nil
+}
+
+// Writes the file SaveDelay from now, unless a write is already waiting.
+func (self *FileCache) scheduleSave() error {
+	delay := self.SaveDelay
+	if delay == 0 {
+		delay = FILE_CACHE_SAVE_DELAY
+	}
+	if delay < 0 {
+		return self.save()
+	}
+
+	self.saveMutex.Lock()
+	defer self.saveMutex.Unlock()
+
+	if self.saveTimer == nil {
+		self.saveTimer = time.AfterFunc(delay, func() {
+			self.saveMutex.Lock()
+			self.saveTimer = nil
+			self.saveMutex.Unlock()
+
+			err := self.save()
+			if err != nil {
+				log.Printf("STAPI: %s\n", err.Error())
+			}
+		})
+	}
+
+	return nil
+}
+
+// Writes any waiting changes now.
+func (self *FileCache) Flush() error {
+	self.saveMutex.Lock()
+	waiting := self.saveTimer != nil && self.saveTimer.Stop()
+	self.saveTimer = nil
+	self.saveMutex.Unlock()
+
+	if !waiting {
+		return nil
+	}
+
+	return self.save()
+}
+
+func (self *FileCache) Set(kind string, key string, value any) error {
+	err := self.MemoryCache.Set(kind, key, value)
+	if err != nil {
+		return err
+	}
+
+	return self.scheduleSave()
+}
+
+func (self *FileCache) Delete(kind string, key string) error {
+	self.MemoryCache.Delete(kind, key)
+
+	return self.scheduleSave()
+}
+
+func (self *FileCache) Clear() error {
+	self.MemoryCache.Clear()
+
+	return self.scheduleSave()
+}
+
+func (self *FileCache) SetResetDate(resetDate string) error {
+	if self.MemoryCache.ResetDate() == resetDate {
+		return nil
+	}
+
+	self.MemoryCache.SetResetDate(resetDate)
+
+	return self.scheduleSave()
+}
+
+var (
+	dataCache         Cache = NewMemoryCache(nil)
+	cacheMutex        sync.Mutex
+	cacheResetChecked time.Time
+)
+
+// Replaces the cache used by GetSystem, GetWaypoint, GetJumpGate and GetSystemWaypoints.
+// Pass nil to turn caching off.
+func SetCache(cache Cache) {
+	cacheMutex.Lock()
+	defer cacheMutex.Unlock()
+
+	dataCache = cache
+	cacheResetChecked = time.Time{}
+}
+
+// Returns the active cache, first clearing it if the server has been reset.
+// The server is only asked every CACHE_RESET_CHECK_INTERVAL.
+// If it can't be reached the cache is used as it is.
+func activeCache() Cache {
+	cacheMutex.Lock()
+	cache := dataCache
+	check := cache != nil && time.Since(cacheResetChecked) >= CACHE_RESET_CHECK_INTERVAL
+	if check {
+		cacheResetChecked = time.Now()
+	}
+	cacheMutex.Unlock()
+
+	// Asked without the lock, so other lookups don't wait on the request.
+	if !check {
+		return cache
+	}
+
+	status, err := GetStatus()
+	if err != nil {
+		return cache
+	}
+
+	err = cache.SetResetDate(status.ResetDate)
+	if err != nil {
+		log.Printf("STAPI: Updating cache reset date. %s\n", err.Error())
+	}
+
+	return cache
+}
+
+func cacheGet(kind string, key string, value any) bool {
+	cache := activeCache()
+	if cache == nil {
+		return false
+	}
+
+	ok, err := cache.Get(kind, key, value)
+	if err != nil {
+		log.Printf("STAPI: %s\n", err.Error())
+		return false
+	}
+
+	return ok
+}
+
+func cacheSet(kind string, key string, value any) {
+	cache := activeCache()
+	if cache == nil {
+		return
+	}
+
+	err := cache.Set(kind, key, value)
+	if err != nil {
+		log.Printf("STAPI: %s\n", err.Error())
+	}
+}
@@ -0,0 +1,193 @@
+package space_traders_api
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/brendoncdodd/space_traders_api/api"
+)
+
+// Generated from the OpenAPI document.
+type (
+	Construction         = api.Construction
+	ConstructionMaterial = api.ConstructionMaterial
+)
+
+// A market that sells something, and how far it is from where it's needed.
+type MarketSource struct {
+	WaypointSymbol string
+	Distance       float64
+	// 0 if the market hasn't been seen with a ship there.
+	PurchasePrice int
+}
+
+// A material a construction site still needs.
+// Sources are markets that sell it, nearest first.
+type ConstructionNeed struct {
+	TradeSymbol TradeSymbol
+	Units       int
+	Sources     []MarketSource
+}
+
+func constructionPath(waypointSymbol string) (string, error) {
+	symbol, err := ParseWaypointSymbol(waypointSymbol)
+	if err != nil {
+		return "", err
+	}
+
+	return "/systems/" + symbol.SystemSymbol() + "/waypoints/" + waypointSymbol + "/construction", nil
+}
+
+// https://api.spacetraders.io/v2/systems/{systemSymbol}/waypoints/{waypointSymbol}/construction
+func GetConstructionSite(waypointSymbol string, token string) (*Construction, error) {
+	errPrefix := "Getting construction site " + waypointSymbol + "."
+
+	symbol, err := ParseWaypointSymbol(waypointSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", errPrefix, err)
+	}
+
+	site, err := apiClient(token).GetConstruction(symbol.SystemSymbol(), waypointSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", errPrefix, err)
+	}
+
+	construction := &Construction{
+		Symbol:     site.Symbol,
+		IsComplete: site.IsComplete,
+	}
+	for _, material := range site.Materials {
+		construction.Materials = append(construction.Materials, ConstructionMaterial{
+			TradeSymbol: material.TradeSymbol,
+			Required:    material.Required,
+			Fulfilled:   material.Fulfilled,
+		})
+	}
+
+	return construction, nil
+}
+
+// Delivers units of tradeSymbol from a ship docked at the construction site.
+func SupplyConstructionSite(
+	waypointSymbol string,
+	shipSymbol string,
+	tradeSymbol TradeSymbol,
+	units int,
+	token string,
+) (*Construction, *ShipCargo, error) {
+	errPrefix := fmt.Sprintf(
+		"Supplying %d %s from %s to %s.",
+		units,
+		tradeSymbol,
+		shipSymbol,
+		waypointSymbol,
+	)
+	respObject := new(struct {
+		Construction *Construction
+		Cargo        *ShipCargo
+	})
+
+	path, err := constructionPath(waypointSymbol)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s %w", errPrefix, err)
+	}
+
+	err = apiAction(
+		"POST",
+		path+"/supply",
+		token,
+		map[string]any{
+			"shipSymbol":  shipSymbol,
+			"tradeSymbol": tradeSymbol,
+			"units":       units,
+		},
+		respObject,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s %w", errPrefix, err)
+	}
+	if respObject.Construction == nil {
+		return nil, respObject.Cargo, fmt.Errorf(
+			"%s %w",
+			errPrefix,
+			NoContentError,
+		)
+	}
+
+	return respObject.Construction, respObject.Cargo, nil
+}
+
+// Works out what a site still needs and which markets sell it.
+// locations maps waypoint symbols to coordinates and must include the site and the markets.
+// Markets without a location are left out.
+func PlanConstructionSupply(
+	site *Construction,
+	markets []Market,
+	locations map[string]Vector2,
+) (needs []ConstructionNeed) {
+	siteLocation := locations[site.Symbol]
+
+	for _, material := range site.RemainingMaterials() {
+		need := ConstructionNeed{
+			TradeSymbol: material.TradeSymbol,
+			Units:       material.Required - material.Fulfilled,
+		}
+
+		for _, market := range markets {
+			location, ok := locations[market.Symbol]
+			if !ok || !market.Sells(material.TradeSymbol) {
+				continue
+			}
+
+			source := MarketSource{
+				WaypointSymbol: market.Symbol,
+				Distance:       siteLocation.Distance(location),
+			}
+			if good := market.TradeGood(material.TradeSymbol); good != nil {
+				source.PurchasePrice = good.PurchasePrice
+			}
+			need.Sources = append(need.Sources, source)
+		}
+
+		sort.Slice(need.Sources, func(i, j int) bool {
+			return need.Sources[i].Distance < need.Sources[j].Distance
+		})
+
+		needs = append(needs, need)
+	}
+
+	return needs
+}
+
+// Gets a construction site and the markets in its system, then plans what to deliver.
+// Only markets in the same system are considered.
+func PlanConstruction(waypointSymbol string, token string) ([]ConstructionNeed, error) {
+	errPrefix := "Planning construction of " + waypointSymbol + "."
+	locations := make(map[string]Vector2)
+
+	site, err := GetConstructionSite(waypointSymbol, token)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", errPrefix, err)
+	}
+
+	siteWaypoint, err := GetWaypoint(waypointSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", errPrefix, err)
+	}
+
+	waypoints, err := GetAllWaypointsInSystem(siteWaypoint.SystemSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", errPrefix, err)
+	}
+	for _, waypoint := range waypoints {
+		locations[waypoint.Symbol] = Vector2{waypoint.X, waypoint.Y}
+	}
+	locations[site.Symbol] = Vector2{siteWaypoint.X, siteWaypoint.Y}
+
+	markets, err := GetSystemMarkets(siteWaypoint.SystemSymbol, token)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", errPrefix, err)
+	}
+
+	return PlanConstructionSupply(site, markets, locations), nil
+}
@@ -0,0 +1,241 @@
+package space_traders_api
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// How a contract looks before it's accepted, or from where it is now if it has been.
+// Payment only counts OnAccepted if the contract hasn't been accepted yet.
+// Goods no market has a price for are listed in Unpriced and cost nothing here.
+// That makes it not Feasible, unless the options had AllowUnpriced set.
+type ContractEvaluation struct {
+	Contract     Contract
+	ShipSymbol   string
+	SourcingCost int
+	Unpriced     []TradeSymbol
+	Fuel         int
+	FuelCost     int
+	Duration     time.Duration
+	Payment      int
+	Profit       int
+	OnTime       bool
+	Feasible     bool
+	Reason       string
+}
+
+func (self ContractEvaluation) String() string {
+	status := "ok"
+	if !self.Feasible {
+		status = self.Reason
+	}
+
+	return fmt.Sprintf(
+		"Contract %s\t%dc profit\t%dc pay\t%dc goods\t%dc fuel\t%s with %s\t%s",
+		self.Contract.ID,
+		self.Profit,
+		self.Payment,
+		self.SourcingCost,
+		self.FuelCost,
+		self.Duration.Round(time.Minute),
+		self.ShipSymbol,
+		status,
+	)
+}
+
+// Estimates a contract for one ship. options.Distance must be set.
+// Uses only the markets given and options.Distance, so it makes no requests unless Distance does.
+func evaluateContractWith(
+	contract Contract,
+	markets []Market,
+	ship TradeShip,
+	options TradeRouteOptions,
+	fuelPrice int,
+	now time.Time,
+) ContractEvaluation {
+	evaluation := ContractEvaluation{
+		Contract:   contract,
+		ShipSymbol: ship.Symbol,
+		Payment:    contract.Terms.Payment.OnFulfilled,
+		Feasible:   true,
+	}
+	if !contract.Accepted {
+		evaluation.Payment += contract.Terms.Payment.OnAccepted
+	}
+
+	mode := options.FlightMode
+	if mode == "" {
+		mode = FLIGHT_MODE_CRUISE
+	}
+	distance := options.Distance
+	capacity := max(ship.CargoCapacity, 1)
+	position := ship.WaypointSymbol
+
+	fly := func(from string, to string) error {
+		if from == "" || from == to {
+			return nil
+		}
+
+		d, err := distance(from, to)
+		if err != nil {
+			return err
+		}
+
+		evaluation.Duration += TravelTime(d, ship.Speed, mode)
+		if ship.FuelCapacity > 0 {
+			fuel := FuelCost(d, mode)
+			if fuel > ship.FuelCapacity {
+				return fmt.Errorf("%s to %s is out of fuel range", from, to)
+			}
+			evaluation.Fuel += fuel
+		}
+
+		return nil
+	}
+
+	for _, deliver := range contract.Terms.Deliver {
+		tradeSymbol := TradeSymbol(deliver.TradeSymbol)
+		remaining := deliver.UnitsRequired - deliver.UnitsFulfilled
+		if remaining <= 0 {
+			continue
+		}
+
+		source, price := cheapestSource(markets, tradeSymbol)
+		if source == "" {
+			evaluation.Feasible = false
+			evaluation.Reason = fmt.Sprintf("no market sells %s", tradeSymbol)
+			continue
+		}
+		if price == 0 {
+			evaluation.Unpriced = append(evaluation.Unpriced, tradeSymbol)
+		}
+		evaluation.SourcingCost += price * remaining
+
+		trips := int(math.Ceil(float64(remaining) / float64(capacity)))
+		for trip := 0; trip < trips; trip++ {
+			err := fly(position, source)
+			if err == nil {
+				err = fly(source, deliver.DestinationSymbol)
+			}
+			if err != nil {
+				evaluation.Feasible = false
+				evaluation.Reason = err.Error()
+				break
+			}
+			position = deliver.DestinationSymbol
+		}
+	}
+
+	if len(evaluation.Unpriced) > 0 && !options.AllowUnpriced && evaluation.Feasible {
+		evaluation.Feasible = false
+		evaluation.Reason = fmt.Sprintf("no prices for %v, can't tell what it would cost", evaluation.Unpriced)
+	}
+
+	evaluation.FuelCost = FuelPurchaseCost(evaluation.Fuel, fuelPrice)
+	evaluation.Profit = evaluation.Payment - evaluation.SourcingCost - evaluation.FuelCost
+
+	deadline, err := time.Parse(time.RFC3339, contract.Terms.Deadline)
+	evaluation.OnTime = err != nil || !now.Add(evaluation.Duration).After(deadline)
+	if !evaluation.OnTime && evaluation.Feasible {
+		evaluation.Feasible = false
+		evaluation.Reason = "can't finish before " + contract.Terms.Deadline
+	}
+
+	return evaluation
+}
+
+// Scores a contract against each ship and keeps the one that would finish soonest.
+// Without options.Distance it asks the API where waypoints are.
+func EvaluateContract(
+	contract Contract,
+	markets []Market,
+	ships []TradeShip,
+	options TradeRouteOptions,
+) ContractEvaluation {
+	if options.Distance == nil {
+		options.Distance = waypointDistance
+	}
+	fuelPrice := options.FuelPrice
+	if fuelPrice <= 0 {
+		fuelPrice = cheapestFuel(markets)
+	}
+	now := time.Now()
+
+	if len(ships) == 0 {
+		ships = []TradeShip{{}}
+	}
+
+	var best *ContractEvaluation
+	for _, ship := range ships {
+		evaluation := evaluateContractWith(contract, markets, ship, options, fuelPrice, now)
+
+		better := best == nil ||
+			(evaluation.Feasible && !best.Feasible) ||
+			(evaluation.Feasible == best.Feasible && evaluation.Duration < best.Duration)
+		if better {
+			best = &evaluation
+		}
+	}
+
+	return *best
+}
+
+// Evaluates every contract that isn't fulfilled, feasible ones first, then by profit.
+// Contracts with unpriced goods aren't feasible, so they come after every priced one
+// unless options.AllowUnpriced is set.
+// options.MinProfit and options.MaxResults apply to the result.
+func RankContracts(
+	contracts []Contract,
+	markets []Market,
+	ships []TradeShip,
+	options TradeRouteOptions,
+) []ContractEvaluation {
+	evaluations := []ContractEvaluation{}
+
+	for _, contract := range contracts {
+		if contract.Fulfilled {
+			continue
+		}
+
+		evaluation := EvaluateContract(contract, markets, ships, options)
+		if evaluation.Profit < options.MinProfit {
+			continue
+		}
+
+		evaluations = append(evaluations, evaluation)
+	}
+
+	sort.SliceStable(evaluations, func(i, j int) bool {
+		if evaluations[i].Feasible != evaluations[j].Feasible {
+			return evaluations[i].Feasible
+		}
+		return evaluations[i].Profit > evaluations[j].Profit
+	})
+
+	if options.MaxResults > 0 && len(evaluations) > options.MaxResults {
+		evaluations = evaluations[:options.MaxResults]
+	}
+
+	return evaluations
+}
+
+// Ranks the agent's open contracts with RankContracts.
+func RankMyContracts(
+	token string,
+	markets []Market,
+	ships []TradeShip,
+	options TradeRouteOptions,
+) ([]ContractEvaluation, error) {
+	contracts, err := GetMyContracts(token)
+	if errors.Is(err, NoContentError) {
+		return []ContractEvaluation{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Ranking contracts. %w", err)
+	}
+
+	return RankContracts(contracts, markets, ships, options), nil
+}
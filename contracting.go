@@ -0,0 +1,467 @@
+package space_traders_api
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// What happened to a contract run by a ContractBehavior.
+type ContractReport struct {
+	ContractID string
+	Delivered  map[TradeSymbol]int
+	Spent      int
+	Earned     int
+	Fulfilled  bool
+	Aborted    bool
+	Reason     string
+	// The contract negotiated after fulfilling this one, if Negotiate was set.
+	Next *Contract
+	// What fulfilling the contract did to reputations, if a tracker was given.
+	Reputation []ReputationChange
+	// Goods the projection had no price for.
+	Unpriced []TradeSymbol
+}
+
+func (self ContractReport) String() string {
+	status := "in progress"
+	switch {
+	case self.Aborted:
+		status = "aborted: " + self.Reason
+	case self.Fulfilled:
+		status = "fulfilled"
+	}
+
+	return fmt.Sprintf(
+		"Contract %s %s\tdelivered %v\tspent %dc\tearned %dc",
+		self.ContractID,
+		status,
+		self.Delivered,
+		self.Spent,
+		self.Earned,
+	)
+}
+
+// A Behavior that fulfills an accepted procurement contract.
+// Each good is bought at the cheapest market that sells it, or mined with Miner
+// if no market does, then delivered in loads as big as the hold.
+// Before starting it projects the cost, fuel included, and time, and aborts if the cost is more than
+// the contract pays on fulfillment, it can't be done before the deadline,
+// or some goods have no known price unless AllowUnpriced is set.
+type ContractBehavior struct {
+	Contract *Contract
+	// Markets to buy from. Loaded from the destination's system if nil.
+	Markets []Market
+	// Mines goods no market sells. Can be nil.
+	Miner *MiningBehavior
+	// Negotiate a new contract at the destination once this one is fulfilled.
+	Negotiate bool
+	// Called once when the contract is fulfilled or aborted. Can be nil.
+	OnDone func(report ContractReport)
+	// Records the reputation change once the contract is fulfilled. Can be nil.
+	Reputation *ReputationTracker
+	// Prices for markets without a ship at them when Markets is loaded. Can be nil.
+	Prices *FilePriceHistory
+	// Go ahead even if some goods have no known price. They count as free in the projection.
+	AllowUnpriced bool
+
+	Report             ContractReport
+	checked            bool
+	reputationRecorded bool
+}
+
+func NewContractBehavior(contract *Contract) *ContractBehavior {
+	return &ContractBehavior{
+		Contract: contract,
+		Report: ContractReport{
+			ContractID: contract.ID,
+			Delivered:  make(map[TradeSymbol]int),
+		},
+	}
+}
+
+// The cheapest market with a price for tradeSymbol.
+// Falls back to any market that trades it, with a price of 0, if none have prices.
+// Empty if no market trades it.
+func cheapestSource(markets []Market, tradeSymbol TradeSymbol) (string, int) {
+	best := ""
+	bestPrice := 0
+	for i := range markets {
+		good := markets[i].TradeGood(tradeSymbol)
+		if good == nil || good.PurchasePrice <= 0 {
+			continue
+		}
+		if best == "" || good.PurchasePrice < bestPrice {
+			best = markets[i].Symbol
+			bestPrice = good.PurchasePrice
+		}
+	}
+	if best != "" {
+		return best, bestPrice
+	}
+
+	for i := range markets {
+		if markets[i].Sells(tradeSymbol) {
+			return markets[i].Symbol, 0
+		}
+	}
+
+	return "", 0
+}
+
+func (self *ContractBehavior) done(report ContractReport) BehaviorStep {
+	if self.OnDone != nil {
+		self.OnDone(report)
+	}
+
+	return BehaviorStep{Action: report.String(), Done: true}
+}
+
+func (self *ContractBehavior) abort(reason string) BehaviorStep {
+	self.Report.Aborted = true
+	self.Report.Reason = reason
+
+	return self.done(self.Report)
+}
+
+// Loads the markets in the destination's system with token, so markets with our ships
+// there have prices. Prices fills in the ones without a ship from the last recorded prices.
+func (self *ContractBehavior) loadMarkets(token string) error {
+	if self.Markets != nil || len(self.Contract.Terms.Deliver) == 0 {
+		return nil
+	}
+
+	symbol, err := ParseWaypointSymbol(self.Contract.Terms.Deliver[0].DestinationSymbol)
+	if err != nil {
+		return err
+	}
+
+	markets, err := GetSystemMarkets(symbol.SystemSymbol(), token)
+	if err != nil {
+		return err
+	}
+	if self.Prices != nil {
+		self.Prices.fillPrices(markets)
+	}
+	self.Markets = markets
+
+	return nil
+}
+
+// Checks the contract is worth doing before anything is bought,
+// with the same estimate RankContracts makes. Goods only Miner can get are left out of it.
+// Returns a reason to abort, or an empty string.
+func (self *ContractBehavior) project(ship *Ship) string {
+	contract := *self.Contract
+	terms := *self.Contract.Terms
+	terms.Deliver = nil
+	contract.Terms = &terms
+	for _, deliver := range self.Contract.Terms.Deliver {
+		tradeSymbol := TradeSymbol(deliver.TradeSymbol)
+		source, _ := cheapestSource(self.Markets, tradeSymbol)
+		if source == "" && self.Miner != nil {
+			continue
+		}
+		contract.Terms.Deliver = append(contract.Terms.Deliver, deliver)
+	}
+
+	evaluation := evaluateContractWith(
+		contract,
+		self.Markets,
+		NewTradeShip(ship),
+		TradeRouteOptions{Distance: waypointDistance, AllowUnpriced: self.AllowUnpriced},
+		cheapestFuel(self.Markets),
+		time.Now(),
+	)
+	self.Report.Unpriced = evaluation.Unpriced
+
+	switch {
+	case !evaluation.Feasible:
+		return evaluation.Reason
+	case evaluation.Profit < 0:
+		return fmt.Sprintf(
+			"projected cost %dc is more than the %dc payment",
+			evaluation.SourcingCost+evaluation.FuelCost,
+			evaluation.Payment,
+		)
+	}
+
+	return ""
+}
+
+func (self *ContractBehavior) Step(ctx context.Context, ship *Ship, token string) (BehaviorStep, error) {
+	errPrefix := "Fulfilling contract " + self.Contract.ID + " with " + ship.Symbol + "."
+
+	if self.Report.Delivered == nil {
+		self.Report.ContractID = self.Contract.ID
+		self.Report.Delivered = make(map[TradeSymbol]int)
+	}
+
+	if !self.Contract.Accepted {
+		return self.abort("contract hasn't been accepted"), nil
+	}
+
+	deadline, err := time.Parse(time.RFC3339, self.Contract.Terms.Deadline)
+	if err == nil && time.Now().After(deadline) {
+		return self.abort("deadline has passed"), nil
+	}
+
+	if !self.checked {
+		err := self.loadMarkets(token)
+		if err != nil {
+			return BehaviorStep{}, fmt.Errorf("%s Loading markets.\n%w", errPrefix, err)
+		}
+		if reason := self.project(ship); reason != "" {
+			return self.abort(reason), nil
+		}
+		self.checked = true
+	}
+
+	// With a full hold, anything the contract needs is delivered before buying more.
+	for _, deliver := range self.Contract.Terms.Deliver {
+		tradeSymbol := TradeSymbol(deliver.TradeSymbol)
+		remaining := self.Contract.Remaining(tradeSymbol)
+		held := ship.Cargo.UnitsOf(tradeSymbol)
+		if ship.Cargo.Free() <= 0 && remaining > 0 && held > 0 {
+			step, err := self.deliver(ship, tradeSymbol, deliver.DestinationSymbol, min(held, remaining), token)
+			if err != nil {
+				return step, fmt.Errorf("%s %w", errPrefix, err)
+			}
+			return step, nil
+		}
+	}
+
+	for _, deliver := range self.Contract.Terms.Deliver {
+		tradeSymbol := TradeSymbol(deliver.TradeSymbol)
+		remaining := self.Contract.Remaining(tradeSymbol)
+		if remaining <= 0 {
+			continue
+		}
+
+		held := ship.Cargo.UnitsOf(tradeSymbol)
+		if held >= remaining {
+			step, err := self.deliver(ship, tradeSymbol, deliver.DestinationSymbol, min(held, remaining), token)
+			if err != nil {
+				return step, fmt.Errorf("%s %w", errPrefix, err)
+			}
+			return step, nil
+		}
+
+		source, _ := cheapestSource(self.Markets, tradeSymbol)
+		if source == "" {
+			if self.Miner == nil {
+				return self.abort(fmt.Sprintf("no market sells %s", tradeSymbol)), nil
+			}
+
+			self.Miner.Contract = self.Contract
+			self.Miner.Keep = func(tradeSymbol TradeSymbol) bool {
+				return self.Contract.Remaining(tradeSymbol) > 0
+			}
+			return self.Miner.Step(ctx, ship, token)
+		}
+
+		step, err := self.buy(ship, source, tradeSymbol, remaining-held, token)
+		if err != nil {
+			return step, fmt.Errorf("%s %w", errPrefix, err)
+		}
+		return step, nil
+	}
+
+	step, err := self.fulfill(ship, token)
+	if err != nil {
+		return step, fmt.Errorf("%s %w", errPrefix, err)
+	}
+
+	return step, nil
+}
+
+func (self *ContractBehavior) deliver(
+	ship *Ship,
+	tradeSymbol TradeSymbol,
+	destination string,
+	units int,
+	token string,
+) (BehaviorStep, error) {
+	arrived, step, err := moveTo(ship, destination, token)
+	if err != nil || !arrived {
+		return step, err
+	}
+	err = ensureDocked(ship, token)
+	if err != nil {
+		return BehaviorStep{}, err
+	}
+
+	contract, cargo, err := DeliverContract(self.Contract.ID, ship.Symbol, tradeSymbol, units, token)
+	if err != nil {
+		return BehaviorStep{}, err
+	}
+	*self.Contract = *contract
+	ship.Cargo = cargo
+	self.Report.Delivered[tradeSymbol] += units
+
+	return BehaviorStep{Action: fmt.Sprintf("delivered %d %s", units, tradeSymbol)}, nil
+}
+
+// Buys up to units of a good at source, as much as fits, then refuels.
+// If nothing fits it makes room first.
+func (self *ContractBehavior) buy(
+	ship *Ship,
+	source string,
+	tradeSymbol TradeSymbol,
+	units int,
+	token string,
+) (BehaviorStep, error) {
+	arrived, step, err := moveTo(ship, source, token)
+	if err != nil || !arrived {
+		return step, err
+	}
+	err = ensureDocked(ship, token)
+	if err != nil {
+		return BehaviorStep{}, err
+	}
+
+	market, err := GetMarket(source, token)
+	if err != nil {
+		return BehaviorStep{}, err
+	}
+	for i := range self.Markets {
+		if self.Markets[i].Symbol == market.Symbol {
+			self.Markets[i] = *market
+		}
+	}
+
+	good := market.TradeGood(tradeSymbol)
+	if good == nil {
+		return BehaviorStep{}, fmt.Errorf("%s doesn't sell %s.", source, tradeSymbol)
+	}
+
+	if ship.Cargo.Free() <= 0 {
+		return self.makeRoom(ship, market, token)
+	}
+
+	units = min(units, ship.Cargo.Free())
+	bought := 0
+	for bought < units {
+		batch := units - bought
+		if good.TradeVolume > 0 && batch > good.TradeVolume {
+			batch = good.TradeVolume
+		}
+
+		result, err := PurchaseCargo(ship.Symbol, tradeSymbol, batch, token)
+		if err != nil {
+			return BehaviorStep{}, err
+		}
+		ship.Cargo = result.Cargo
+		if result.Transaction != nil {
+			self.Report.Spent += result.Transaction.TotalPrice
+		}
+		bought += batch
+	}
+
+	if market.Trades(TRADE_SYMBOL_FUEL) && ship.Fuel != nil && ship.Fuel.Current < ship.Fuel.Capacity {
+		result, err := RefuelShip(ship.Symbol, 0, false, token)
+		if err != nil {
+			return BehaviorStep{}, err
+		}
+		ship.Fuel = result.Fuel
+		if result.Transaction != nil {
+			self.Report.Spent += result.Transaction.TotalPrice
+		}
+	}
+
+	return BehaviorStep{Action: fmt.Sprintf("bought %d %s at %s", bought, tradeSymbol, source)}, nil
+}
+
+// Sells whatever the contract doesn't need to the market the ship is docked at,
+// and jettisons what the market doesn't buy.
+// Aborts if there's nothing to make room with.
+func (self *ContractBehavior) makeRoom(ship *Ship, market *Market, token string) (BehaviorStep, error) {
+	if ship.Cargo == nil {
+		return self.abort("the ship has no cargo hold"), nil
+	}
+
+	sold := 0
+	jettisoned := 0
+	for _, item := range append([]ShipCargoItem{}, ship.Cargo.Inventory...) {
+		units := item.Units - self.Contract.Remaining(item.Symbol)
+		if units <= 0 {
+			continue
+		}
+
+		good := market.TradeGood(item.Symbol)
+		if good == nil {
+			cargo, err := JettisonCargo(ship.Symbol, item.Symbol, units, token)
+			if err != nil {
+				return BehaviorStep{}, err
+			}
+			ship.Cargo = cargo
+			jettisoned += units
+			continue
+		}
+
+		for units > 0 {
+			batch := units
+			if good.TradeVolume > 0 && batch > good.TradeVolume {
+				batch = good.TradeVolume
+			}
+
+			result, err := SellCargo(ship.Symbol, item.Symbol, batch, token)
+			if err != nil {
+				return BehaviorStep{}, err
+			}
+			ship.Cargo = result.Cargo
+			if result.Transaction != nil {
+				self.Report.Earned += result.Transaction.TotalPrice
+			}
+			sold += batch
+			units -= batch
+		}
+	}
+
+	if sold == 0 && jettisoned == 0 {
+		return self.abort("the hold is full of goods the contract still needs"), nil
+	}
+
+	return BehaviorStep{
+		Action: fmt.Sprintf("made room in the hold, sold %d and jettisoned %d units", sold, jettisoned),
+	}, nil
+}
+
+// Fulfills the contract, records the reputation change and, if asked,
+// negotiates the next one where the ship is.
+// A retry after a failed step doesn't repeat the ones before it.
+func (self *ContractBehavior) fulfill(ship *Ship, token string) (BehaviorStep, error) {
+	if !self.Report.Fulfilled {
+		contract, _, err := FulfillContract(self.Contract.ID, token)
+		if err != nil {
+			return BehaviorStep{}, err
+		}
+		*self.Contract = *contract
+		self.Report.Fulfilled = true
+		self.Report.Earned += self.Contract.Terms.Payment.OnFulfilled
+	}
+
+	if self.Reputation != nil && !self.reputationRecorded {
+		changes, err := self.Reputation.Record(token, self.Contract.ID)
+		if err != nil {
+			return BehaviorStep{}, err
+		}
+		self.Report.Reputation = changes
+		self.reputationRecorded = true
+	}
+
+	if self.Negotiate {
+		err := ensureDocked(ship, token)
+		if err != nil {
+			return BehaviorStep{}, err
+		}
+
+		next, err := NegotiateContract(ship.Symbol, token)
+		if err != nil {
+			return BehaviorStep{}, err
+		}
+		self.Report.Next = next
+	}
+
+	return self.done(self.Report), nil
+}
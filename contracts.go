@@ -7,66 +7,17 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
-)
-
-type Contract struct {
-	ID            string
-	FactionSymbol string
-	Type          string
-	Terms         struct {
-		Deadline string
-		Payment  struct {
-			OnAccepted  int
-			OnFulfilled int
-		}
-		Deliver []struct {
-			TradeSymbol       string
-			DestinationSymbol string
-			UnitsRequired     int
-			UnitsFulfilled    int
-		}
-	}
-	Accepted         bool
-	Fulfilled        bool
-	Expiration       string
-	DeadlineToAccept string
-}
-
-func (self Contract) String() string {
-	ret := fmt.Sprintf(
-		"Contract Expires %s\n"+
-			"\t%s\n"+
-			"\t%s\n"+
-			"\tType\t%s\n"+
-			"\tTerms\n"+
-			"\t\tDeadline\t%s\n"+
-			"\t\tUp Front\t%dc\n"+
-			"\t\tFulfilled\t%dc\n"+
-			"\t\tDeliver\t%v\n",
-		self.Expiration,
-		self.ID,
-		self.FactionSymbol,
-		self.Type,
-		self.Terms.Deadline,
-		self.Terms.Payment.OnAccepted,
-		self.Terms.Payment.OnFulfilled,
-		self.Terms.Deliver,
-	)
 
-	if !self.Accepted {
-		ret += fmt.Sprintf(
-			"\tDeadlineToAccept\t%s\n",
-			self.DeadlineToAccept,
-		)
-
-	}
-
-	if self.Fulfilled {
-		ret += fmt.Sprintf("\tFULFILLED")
-	}
+	"github.com/brendoncdodd/space_traders_api/api"
+)
 
-	return ret
-}
+// Generated from the OpenAPI document.
+type (
+	Contract            = api.Contract
+	ContractTerms       = api.ContractTerms
+	ContractPayment     = api.ContractPayment
+	ContractDeliverGood = api.ContractDeliverGood
+)
 
 const BASE_URL = "https://api.spacetraders.io/v2"
 
@@ -143,3 +94,110 @@ func GetMyContracts(token string) ([]Contract, error) {
 
 	return contracts, nil
 }
+
+// POST /my/contracts/{contractID}/{action}, decoding data into result.
+func contractAction(contractID string, action string, token string, body any, result any) error {
+	return apiAction("POST", "/my/contracts/"+contractID+"/"+action, token, body, result)
+}
+
+func AcceptContract(contractID string, token string) (*Contract, *Agent, error) {
+	respObject := new(struct {
+		Contract *Contract
+		Agent    *Agent
+	})
+
+	err := contractAction(contractID, "accept", token, nil, respObject)
+	if err != nil {
+		return nil, nil, err
+	}
+	if respObject.Contract == nil {
+		return nil, respObject.Agent, fmt.Errorf(
+			"Accepting contract %s. %w",
+			contractID,
+			NoContentError,
+		)
+	}
+
+	return respObject.Contract, respObject.Agent, nil
+}
+
+// Delivers cargo from a ship docked at the contract's destination.
+func DeliverContract(
+	contractID string,
+	shipSymbol string,
+	tradeSymbol TradeSymbol,
+	units int,
+	token string,
+) (*Contract, *ShipCargo, error) {
+	respObject := new(struct {
+		Contract *Contract
+		Cargo    *ShipCargo
+	})
+
+	err := contractAction(
+		contractID,
+		"deliver",
+		token,
+		map[string]any{
+			"shipSymbol":  shipSymbol,
+			"tradeSymbol": tradeSymbol,
+			"units":       units,
+		},
+		respObject,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	if respObject.Contract == nil {
+		return nil, respObject.Cargo, fmt.Errorf(
+			"Delivering %d %s to contract %s. %w",
+			units,
+			tradeSymbol,
+			contractID,
+			NoContentError,
+		)
+	}
+
+	return respObject.Contract, respObject.Cargo, nil
+}
+
+// Fulfills a contract once everything has been delivered.
+func FulfillContract(contractID string, token string) (*Contract, *Agent, error) {
+	respObject := new(struct {
+		Contract *Contract
+		Agent    *Agent
+	})
+
+	err := contractAction(contractID, "fulfill", token, nil, respObject)
+	if err != nil {
+		return nil, nil, err
+	}
+	if respObject.Contract == nil {
+		return nil, respObject.Agent, fmt.Errorf(
+			"Fulfilling contract %s. %w",
+			contractID,
+			NoContentError,
+		)
+	}
+
+	return respObject.Contract, respObject.Agent, nil
+}
+
+// Asks for a new contract. The ship must be docked at a faction's waypoint.
+func NegotiateContract(shipSymbol string, token string) (*Contract, error) {
+	respObject := new(struct{ Contract *Contract })
+
+	err := shipAction("POST", shipSymbol, "negotiate/contract", token, nil, respObject)
+	if err != nil {
+		return nil, err
+	}
+	if respObject.Contract == nil {
+		return nil, fmt.Errorf(
+			"Negotiating contract with %s. %w",
+			shipSymbol,
+			NoContentError,
+		)
+	}
+
+	return respObject.Contract, nil
+}
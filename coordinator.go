@@ -0,0 +1,427 @@
+package space_traders_api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Kinds of job. Only used for reporting, what a job does is up to its Behavior.
+const (
+	JOB_MINE     = "MINE"
+	JOB_HAUL     = "HAUL"
+	JOB_PROBE    = "PROBE"
+	JOB_CONTRACT = "CONTRACT"
+)
+
+// Something for ships to do.
+// Waypoint is where the job happens and is used to pick the closest ship.
+// Markets are the ones the job buys or sells at, so the coordinator can keep
+// too many ships from trading at the same one.
+// Roles and CanDo limit which ships can take it. Both are optional.
+// Slots is how many ships can work it at once, default 1.
+// Higher priority jobs are filled first, and take ships from lower priority ones if they must.
+// Unless Repeat is set, the job is removed once a ship's behavior for it is done.
+type Job struct {
+	ID       string
+	Type     string
+	Waypoint string
+	Markets  []string
+	Roles    []ShipRole
+	Slots    int
+	Priority int
+	Repeat   bool
+	CanDo    func(ship *Ship) bool
+	// Makes the behavior for a ship that's been assigned the job.
+	Behavior func(ship *Ship) Behavior
+}
+
+func (self *Job) slots() int {
+	if self.Slots <= 0 {
+		return 1
+	}
+
+	return self.Slots
+}
+
+func (self *Job) accepts(ship *Ship) bool {
+	if len(self.Roles) > 0 {
+		if ship.Registration == nil {
+			return false
+		}
+
+		found := false
+		for _, role := range self.Roles {
+			if role == ship.Registration.Role {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return self.CanDo == nil || self.CanDo(ship)
+}
+
+type JobAssignment struct {
+	ShipSymbol string
+	JobID      string
+	// The job the ship was taken off, if any.
+	PreviousJobID string
+}
+
+// Hands jobs to the agent's ships and runs them with a Runner.
+// Each Rebalance assigns idle ships, frees the slots of ships that are gone,
+// and moves ships off lower priority jobs when a higher one can't be filled otherwise.
+type Coordinator struct {
+	Token  string
+	Runner *Runner
+	// How often Run looks at the fleet. Default 1 minute.
+	Interval time.Duration
+	// How many ships can use one market at once, across all jobs. Default 1.
+	MaxShipsPerMarket int
+	// Distance between waypoints, used to pick the closest ship. Defaults to GetWaypointLocation.
+	Distance func(from string, to string) (float64, error)
+	// Called for every assignment. Can be nil.
+	OnAssign func(assignment JobAssignment)
+
+	mutex       sync.Mutex
+	jobs        map[string]*Job
+	assignments map[string]string
+}
+
+func NewCoordinator(token string, runner *Runner) *Coordinator {
+	if runner == nil {
+		runner = NewRunner(token)
+	}
+
+	return &Coordinator{
+		Token:       token,
+		Runner:      runner,
+		jobs:        make(map[string]*Job),
+		assignments: make(map[string]string),
+	}
+}
+
+func (self *Coordinator) AddJob(job Job) error {
+	if job.ID == "" {
+		return fmt.Errorf("Adding job. Job has no ID.")
+	}
+	if job.Behavior == nil {
+		return fmt.Errorf("Adding job %s. Job has no Behavior.", job.ID)
+	}
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if self.jobs == nil {
+		self.jobs = make(map[string]*Job)
+	}
+	self.jobs[job.ID] = &job
+
+	return nil
+}
+
+// Removes a job and stops the ships working it.
+func (self *Coordinator) RemoveJob(jobID string) {
+	for _, shipSymbol := range self.dropJob(jobID) {
+		self.Runner.Stop(shipSymbol)
+	}
+}
+
+// Deletes a job and its assignments. Returns the ships that were assigned to it.
+func (self *Coordinator) dropJob(jobID string) []string {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	delete(self.jobs, jobID)
+	dropped := []string{}
+	for shipSymbol, assigned := range self.assignments {
+		if assigned == jobID {
+			dropped = append(dropped, shipSymbol)
+			delete(self.assignments, shipSymbol)
+		}
+	}
+
+	return dropped
+}
+
+func (self *Coordinator) Jobs() []Job {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	jobs := []Job{}
+	for _, job := range self.jobs {
+		jobs = append(jobs, *job)
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].ID < jobs[j].ID })
+
+	return jobs
+}
+
+// Ship symbol to job ID.
+func (self *Coordinator) Assignments() map[string]string {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	assignments := make(map[string]string)
+	for shipSymbol, jobID := range self.assignments {
+		assignments[shipSymbol] = jobID
+	}
+
+	return assignments
+}
+
+// Drops assignments for ships that are gone, that stopped running, or whose job is gone.
+// Must be called with the mutex held.
+func (self *Coordinator) prune(ships []Ship, running map[string]bool) {
+	present := make(map[string]bool)
+	for _, ship := range ships {
+		present[ship.Symbol] = true
+	}
+
+	for shipSymbol, jobID := range self.assignments {
+		_, jobExists := self.jobs[jobID]
+		if !present[shipSymbol] || !jobExists || (running != nil && !running[shipSymbol]) {
+			delete(self.assignments, shipSymbol)
+		}
+	}
+}
+
+// How far each ship is from each job's waypoint, by ship symbol then waypoint.
+// Distance can make requests, so the mutex is only held to read the jobs.
+// Pairs Distance fails for are left out.
+func (self *Coordinator) jobDistances(ships []Ship) map[string]map[string]float64 {
+	distance := self.Distance
+	if distance == nil {
+		distance = waypointDistance
+	}
+
+	self.mutex.Lock()
+	waypoints := make(map[string]bool)
+	for _, job := range self.jobs {
+		if job.Waypoint != "" {
+			waypoints[job.Waypoint] = true
+		}
+	}
+	self.mutex.Unlock()
+
+	distances := make(map[string]map[string]float64)
+	for _, ship := range ships {
+		if ship.Nav == nil {
+			continue
+		}
+
+		distances[ship.Symbol] = make(map[string]float64)
+		for waypoint := range waypoints {
+			if d, err := distance(ship.Nav.WaypointSymbol, waypoint); err == nil {
+				distances[ship.Symbol][waypoint] = d
+			}
+		}
+	}
+
+	return distances
+}
+
+// Works out new assignments without starting anything.
+// distances is from jobDistances. Ships it has no distance for are picked last.
+// Must be called with the mutex held.
+func (self *Coordinator) plan(ships []Ship, distances map[string]map[string]float64) []JobAssignment {
+	maxPerMarket := self.MaxShipsPerMarket
+	if maxPerMarket <= 0 {
+		maxPerMarket = 1
+	}
+
+	jobs := []*Job{}
+	for _, job := range self.jobs {
+		jobs = append(jobs, job)
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		if jobs[i].Priority != jobs[j].Priority {
+			return jobs[i].Priority > jobs[j].Priority
+		}
+		// Jobs only some ships can do go first, so others don't take those ships.
+		if (len(jobs[i].Roles) > 0) != (len(jobs[j].Roles) > 0) {
+			return len(jobs[i].Roles) > 0
+		}
+		return jobs[i].ID < jobs[j].ID
+	})
+
+	filled := make(map[string]int)
+	marketUse := make(map[string]int)
+	for _, jobID := range self.assignments {
+		filled[jobID]++
+		for _, market := range self.jobs[jobID].Markets {
+			marketUse[market]++
+		}
+	}
+
+	planned := []JobAssignment{}
+	for _, job := range jobs {
+		for filled[job.ID] < job.slots() {
+			marketsFull := false
+			for _, market := range job.Markets {
+				if marketUse[market] >= maxPerMarket {
+					marketsFull = true
+				}
+			}
+			if marketsFull {
+				break
+			}
+
+			best := -1
+			bestScore := math.Inf(1)
+			for i := range ships {
+				ship := &ships[i]
+				if !job.accepts(ship) {
+					continue
+				}
+
+				current, assigned := self.assignments[ship.Symbol]
+				if assigned && (current == job.ID || self.jobs[current].Priority >= job.Priority) {
+					continue
+				}
+
+				score := math.Inf(1)
+				if job.Waypoint == "" {
+					score = 0
+				} else if d, ok := distances[ship.Symbol][job.Waypoint]; ok {
+					score = d
+				}
+				// Idle ships before taking one off another job.
+				if assigned {
+					score += 1e9
+				}
+
+				if best == -1 || score < bestScore {
+					best = i
+					bestScore = score
+				}
+			}
+			if best == -1 {
+				break
+			}
+
+			ship := &ships[best]
+			assignment := JobAssignment{ShipSymbol: ship.Symbol, JobID: job.ID}
+			if previous, ok := self.assignments[ship.Symbol]; ok {
+				assignment.PreviousJobID = previous
+				filled[previous]--
+				for _, market := range self.jobs[previous].Markets {
+					marketUse[market]--
+				}
+			}
+
+			self.assignments[ship.Symbol] = job.ID
+			filled[job.ID]++
+			for _, market := range job.Markets {
+				marketUse[market]++
+			}
+			planned = append(planned, assignment)
+		}
+	}
+
+	return planned
+}
+
+// Wraps a job's behavior so the job can be removed when it's done.
+type jobBehavior struct {
+	coordinator *Coordinator
+	job         *Job
+	inner       Behavior
+}
+
+func (self *jobBehavior) Step(ctx context.Context, ship *Ship, token string) (BehaviorStep, error) {
+	step, err := self.inner.Step(ctx, ship, token)
+	if err == nil && step.Done && !self.job.Repeat {
+		// The other ships on the job would only keep doing it again.
+		for _, shipSymbol := range self.coordinator.dropJob(self.job.ID) {
+			if shipSymbol != ship.Symbol {
+				self.coordinator.Runner.Stop(shipSymbol)
+			}
+		}
+	}
+
+	return step, err
+}
+
+// Assigns jobs to ships and starts them on the Runner.
+// ships should be the whole fleet, as from GetShipsByAgent.
+func (self *Coordinator) Rebalance(ctx context.Context, ships []Ship) []JobAssignment {
+	running := make(map[string]bool)
+	for _, shipSymbol := range self.Runner.Running() {
+		running[shipSymbol] = true
+	}
+
+	distances := self.jobDistances(ships)
+
+	self.mutex.Lock()
+	if self.assignments == nil {
+		self.assignments = make(map[string]string)
+	}
+	self.prune(ships, running)
+	planned := self.plan(ships, distances)
+
+	starting := make(map[string]*Job)
+	for _, assignment := range planned {
+		starting[assignment.ShipSymbol] = self.jobs[assignment.JobID]
+	}
+	self.mutex.Unlock()
+
+	for i := range ships {
+		job, ok := starting[ships[i].Symbol]
+		if !ok {
+			continue
+		}
+
+		ship := ships[i]
+		self.Runner.Start(ctx, ship, &jobBehavior{
+			coordinator: self,
+			job:         job,
+			inner:       job.Behavior(&ship),
+		})
+	}
+
+	if self.OnAssign != nil {
+		for _, assignment := range planned {
+			self.OnAssign(assignment)
+		}
+	}
+
+	return planned
+}
+
+// Rebalances every Interval until ctx is done, then waits for the ships to stop.
+// Errors getting the fleet are passed to onError if it isn't nil.
+func (self *Coordinator) Run(ctx context.Context, onError func(error)) {
+	interval := self.Interval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		ships, err := GetShipsByAgent(self.Token)
+		if err != nil {
+			if onError != nil {
+				onError(fmt.Errorf("Coordinating fleet. %w", err))
+			}
+		} else {
+			self.Rebalance(ctx, ships)
+		}
+
+		select {
+		case <-ctx.Done():
+			self.Runner.Wait()
+			return
+		case <-ticker.C:
+		}
+	}
+}
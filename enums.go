@@ -0,0 +1,12 @@
+package space_traders_api
+
+import (
+	"github.com/brendoncdodd/space_traders_api/api"
+)
+
+// enums_gen.go is written by go generate in ./api, after the package it aliases.
+
+// The enum types are plain strings, so values the server adds after a reset
+// still decode. Call Validate on input from people to catch typos.
+// The types live in the api package and are aliased here.
+var UnknownEnumError = api.UnknownEnumError
@@ -0,0 +1,741 @@
+// Code generated by enumgen from github.com/brendoncdodd/space_traders_api/api. DO NOT EDIT.
+
+package space_traders_api
+
+import "github.com/brendoncdodd/space_traders_api/api"
+
+// The activity level of a trade good. If the good is an import, this represents how strong consumption is. If the good is an export, this represents how strong the production is for the good. When activity is strong, consumption or production is near maximum capacity. When activity is weak, consumption or production is near minimum capacity.
+type ActivityLevel = api.ActivityLevel
+
+const (
+	ACTIVITY_WEAK       = api.ACTIVITY_WEAK
+	ACTIVITY_GROWING    = api.ACTIVITY_GROWING
+	ACTIVITY_STRONG     = api.ACTIVITY_STRONG
+	ACTIVITY_RESTRICTED = api.ACTIVITY_RESTRICTED
+)
+
+// Every ActivityLevel this package knows about.
+func AllActivityLevels() []ActivityLevel {
+	return api.AllActivityLevels()
+}
+
+// The symbol of the faction.
+type FactionSymbol = api.FactionSymbol
+
+const (
+	FACTION_COSMIC   = api.FACTION_COSMIC
+	FACTION_VOID     = api.FACTION_VOID
+	FACTION_GALACTIC = api.FACTION_GALACTIC
+	FACTION_QUANTUM  = api.FACTION_QUANTUM
+	FACTION_DOMINION = api.FACTION_DOMINION
+	FACTION_ASTRO    = api.FACTION_ASTRO
+	FACTION_CORSAIRS = api.FACTION_CORSAIRS
+	FACTION_OBSIDIAN = api.FACTION_OBSIDIAN
+	FACTION_AEGIS    = api.FACTION_AEGIS
+	FACTION_UNITED   = api.FACTION_UNITED
+	FACTION_SOLITARY = api.FACTION_SOLITARY
+	FACTION_COBALT   = api.FACTION_COBALT
+	FACTION_OMEGA    = api.FACTION_OMEGA
+	FACTION_ECHO     = api.FACTION_ECHO
+	FACTION_LORDS    = api.FACTION_LORDS
+	FACTION_CULT     = api.FACTION_CULT
+	FACTION_ANCIENTS = api.FACTION_ANCIENTS
+	FACTION_SHADOW   = api.FACTION_SHADOW
+	FACTION_ETHEREAL = api.FACTION_ETHEREAL
+)
+
+// Every FactionSymbol this package knows about.
+func AllFactionSymbols() []FactionSymbol {
+	return api.AllFactionSymbols()
+}
+
+// The unique identifier of the trait.
+type FactionTraitSymbol = api.FactionTraitSymbol
+
+const (
+	FACTION_TRAIT_BUREAUCRATIC             = api.FACTION_TRAIT_BUREAUCRATIC
+	FACTION_TRAIT_SECRETIVE                = api.FACTION_TRAIT_SECRETIVE
+	FACTION_TRAIT_CAPITALISTIC             = api.FACTION_TRAIT_CAPITALISTIC
+	FACTION_TRAIT_INDUSTRIOUS              = api.FACTION_TRAIT_INDUSTRIOUS
+	FACTION_TRAIT_PEACEFUL                 = api.FACTION_TRAIT_PEACEFUL
+	FACTION_TRAIT_DISTRUSTFUL              = api.FACTION_TRAIT_DISTRUSTFUL
+	FACTION_TRAIT_WELCOMING                = api.FACTION_TRAIT_WELCOMING
+	FACTION_TRAIT_SMUGGLERS                = api.FACTION_TRAIT_SMUGGLERS
+	FACTION_TRAIT_SCAVENGERS               = api.FACTION_TRAIT_SCAVENGERS
+	FACTION_TRAIT_REBELLIOUS               = api.FACTION_TRAIT_REBELLIOUS
+	FACTION_TRAIT_EXILES                   = api.FACTION_TRAIT_EXILES
+	FACTION_TRAIT_PIRATES                  = api.FACTION_TRAIT_PIRATES
+	FACTION_TRAIT_RAIDERS                  = api.FACTION_TRAIT_RAIDERS
+	FACTION_TRAIT_CLAN                     = api.FACTION_TRAIT_CLAN
+	FACTION_TRAIT_GUILD                    = api.FACTION_TRAIT_GUILD
+	FACTION_TRAIT_DOMINION                 = api.FACTION_TRAIT_DOMINION
+	FACTION_TRAIT_FRINGE                   = api.FACTION_TRAIT_FRINGE
+	FACTION_TRAIT_FORSAKEN                 = api.FACTION_TRAIT_FORSAKEN
+	FACTION_TRAIT_ISOLATED                 = api.FACTION_TRAIT_ISOLATED
+	FACTION_TRAIT_LOCALIZED                = api.FACTION_TRAIT_LOCALIZED
+	FACTION_TRAIT_ESTABLISHED              = api.FACTION_TRAIT_ESTABLISHED
+	FACTION_TRAIT_NOTABLE                  = api.FACTION_TRAIT_NOTABLE
+	FACTION_TRAIT_DOMINANT                 = api.FACTION_TRAIT_DOMINANT
+	FACTION_TRAIT_INESCAPABLE              = api.FACTION_TRAIT_INESCAPABLE
+	FACTION_TRAIT_INNOVATIVE               = api.FACTION_TRAIT_INNOVATIVE
+	FACTION_TRAIT_BOLD                     = api.FACTION_TRAIT_BOLD
+	FACTION_TRAIT_VISIONARY                = api.FACTION_TRAIT_VISIONARY
+	FACTION_TRAIT_CURIOUS                  = api.FACTION_TRAIT_CURIOUS
+	FACTION_TRAIT_DARING                   = api.FACTION_TRAIT_DARING
+	FACTION_TRAIT_EXPLORATORY              = api.FACTION_TRAIT_EXPLORATORY
+	FACTION_TRAIT_RESOURCEFUL              = api.FACTION_TRAIT_RESOURCEFUL
+	FACTION_TRAIT_FLEXIBLE                 = api.FACTION_TRAIT_FLEXIBLE
+	FACTION_TRAIT_COOPERATIVE              = api.FACTION_TRAIT_COOPERATIVE
+	FACTION_TRAIT_UNITED                   = api.FACTION_TRAIT_UNITED
+	FACTION_TRAIT_STRATEGIC                = api.FACTION_TRAIT_STRATEGIC
+	FACTION_TRAIT_INTELLIGENT              = api.FACTION_TRAIT_INTELLIGENT
+	FACTION_TRAIT_RESEARCH_FOCUSED         = api.FACTION_TRAIT_RESEARCH_FOCUSED
+	FACTION_TRAIT_COLLABORATIVE            = api.FACTION_TRAIT_COLLABORATIVE
+	FACTION_TRAIT_PROGRESSIVE              = api.FACTION_TRAIT_PROGRESSIVE
+	FACTION_TRAIT_MILITARISTIC             = api.FACTION_TRAIT_MILITARISTIC
+	FACTION_TRAIT_TECHNOLOGICALLY_ADVANCED = api.FACTION_TRAIT_TECHNOLOGICALLY_ADVANCED
+	FACTION_TRAIT_AGGRESSIVE               = api.FACTION_TRAIT_AGGRESSIVE
+	FACTION_TRAIT_IMPERIALISTIC            = api.FACTION_TRAIT_IMPERIALISTIC
+	FACTION_TRAIT_TREASURE_HUNTERS         = api.FACTION_TRAIT_TREASURE_HUNTERS
+	FACTION_TRAIT_DEXTEROUS                = api.FACTION_TRAIT_DEXTEROUS
+	FACTION_TRAIT_UNPREDICTABLE            = api.FACTION_TRAIT_UNPREDICTABLE
+	FACTION_TRAIT_BRUTAL                   = api.FACTION_TRAIT_BRUTAL
+	FACTION_TRAIT_FLEETING                 = api.FACTION_TRAIT_FLEETING
+	FACTION_TRAIT_ADAPTABLE                = api.FACTION_TRAIT_ADAPTABLE
+	FACTION_TRAIT_SELF_SUFFICIENT          = api.FACTION_TRAIT_SELF_SUFFICIENT
+	FACTION_TRAIT_DEFENSIVE                = api.FACTION_TRAIT_DEFENSIVE
+	FACTION_TRAIT_PROUD                    = api.FACTION_TRAIT_PROUD
+	FACTION_TRAIT_DIVERSE                  = api.FACTION_TRAIT_DIVERSE
+	FACTION_TRAIT_INDEPENDENT              = api.FACTION_TRAIT_INDEPENDENT
+	FACTION_TRAIT_SELF_INTERESTED          = api.FACTION_TRAIT_SELF_INTERESTED
+	FACTION_TRAIT_FRAGMENTED               = api.FACTION_TRAIT_FRAGMENTED
+	FACTION_TRAIT_COMMERCIAL               = api.FACTION_TRAIT_COMMERCIAL
+	FACTION_TRAIT_FREE_MARKETS             = api.FACTION_TRAIT_FREE_MARKETS
+	FACTION_TRAIT_ENTREPRENEURIAL          = api.FACTION_TRAIT_ENTREPRENEURIAL
+)
+
+// Every FactionTraitSymbol this package knows about.
+func AllFactionTraitSymbols() []FactionTraitSymbol {
+	return api.AllFactionTraitSymbols()
+}
+
+// The ship's set speed when traveling between waypoints or systems.
+type ShipNavFlightMode = api.ShipNavFlightMode
+
+const (
+	FLIGHT_MODE_DRIFT   = api.FLIGHT_MODE_DRIFT
+	FLIGHT_MODE_STEALTH = api.FLIGHT_MODE_STEALTH
+	FLIGHT_MODE_CRUISE  = api.FLIGHT_MODE_CRUISE
+	FLIGHT_MODE_BURN    = api.FLIGHT_MODE_BURN
+)
+
+// Every ShipNavFlightMode this package knows about.
+func AllShipNavFlightModes() []ShipNavFlightMode {
+	return api.AllShipNavFlightModes()
+}
+
+// The current status of the ship
+type ShipNavStatus = api.ShipNavStatus
+
+const (
+	NAV_STATUS_IN_TRANSIT = api.NAV_STATUS_IN_TRANSIT
+	NAV_STATUS_IN_ORBIT   = api.NAV_STATUS_IN_ORBIT
+	NAV_STATUS_DOCKED     = api.NAV_STATUS_DOCKED
+)
+
+// Every ShipNavStatus this package knows about.
+func AllShipNavStatuses() []ShipNavStatus {
+	return api.AllShipNavStatuses()
+}
+
+// The registered role of the ship
+type ShipRole = api.ShipRole
+
+const (
+	SHIP_ROLE_FABRICATOR  = api.SHIP_ROLE_FABRICATOR
+	SHIP_ROLE_HARVESTER   = api.SHIP_ROLE_HARVESTER
+	SHIP_ROLE_HAULER      = api.SHIP_ROLE_HAULER
+	SHIP_ROLE_INTERCEPTOR = api.SHIP_ROLE_INTERCEPTOR
+	SHIP_ROLE_EXCAVATOR   = api.SHIP_ROLE_EXCAVATOR
+	SHIP_ROLE_TRANSPORT   = api.SHIP_ROLE_TRANSPORT
+	SHIP_ROLE_REPAIR      = api.SHIP_ROLE_REPAIR
+	SHIP_ROLE_SURVEYOR    = api.SHIP_ROLE_SURVEYOR
+	SHIP_ROLE_COMMAND     = api.SHIP_ROLE_COMMAND
+	SHIP_ROLE_CARRIER     = api.SHIP_ROLE_CARRIER
+	SHIP_ROLE_PATROL      = api.SHIP_ROLE_PATROL
+	SHIP_ROLE_SATELLITE   = api.SHIP_ROLE_SATELLITE
+	SHIP_ROLE_EXPLORER    = api.SHIP_ROLE_EXPLORER
+	SHIP_ROLE_REFINERY    = api.SHIP_ROLE_REFINERY
+)
+
+// Every ShipRole this package knows about.
+func AllShipRoles() []ShipRole {
+	return api.AllShipRoles()
+}
+
+// Type of ship
+type ShipType = api.ShipType
+
+const (
+	SHIP_PROBE              = api.SHIP_PROBE
+	SHIP_MINING_DRONE       = api.SHIP_MINING_DRONE
+	SHIP_SIPHON_DRONE       = api.SHIP_SIPHON_DRONE
+	SHIP_INTERCEPTOR        = api.SHIP_INTERCEPTOR
+	SHIP_LIGHT_HAULER       = api.SHIP_LIGHT_HAULER
+	SHIP_COMMAND_FRIGATE    = api.SHIP_COMMAND_FRIGATE
+	SHIP_EXPLORER           = api.SHIP_EXPLORER
+	SHIP_HEAVY_FREIGHTER    = api.SHIP_HEAVY_FREIGHTER
+	SHIP_LIGHT_SHUTTLE      = api.SHIP_LIGHT_SHUTTLE
+	SHIP_ORE_HOUND          = api.SHIP_ORE_HOUND
+	SHIP_REFINING_FREIGHTER = api.SHIP_REFINING_FREIGHTER
+	SHIP_SURVEYOR           = api.SHIP_SURVEYOR
+)
+
+// Every ShipType this package knows about.
+func AllShipTypes() []ShipType {
+	return api.AllShipTypes()
+}
+
+// The supply level of a trade good.
+type SupplyLevel = api.SupplyLevel
+
+const (
+	SUPPLY_SCARCE   = api.SUPPLY_SCARCE
+	SUPPLY_LIMITED  = api.SUPPLY_LIMITED
+	SUPPLY_MODERATE = api.SUPPLY_MODERATE
+	SUPPLY_HIGH     = api.SUPPLY_HIGH
+	SUPPLY_ABUNDANT = api.SUPPLY_ABUNDANT
+)
+
+// Every SupplyLevel this package knows about.
+func AllSupplyLevels() []SupplyLevel {
+	return api.AllSupplyLevels()
+}
+
+// The type of system.
+type SystemType = api.SystemType
+
+const (
+	SYSTEM_TYPE_NEUTRON_STAR = api.SYSTEM_TYPE_NEUTRON_STAR
+	SYSTEM_TYPE_RED_STAR     = api.SYSTEM_TYPE_RED_STAR
+	SYSTEM_TYPE_ORANGE_STAR  = api.SYSTEM_TYPE_ORANGE_STAR
+	SYSTEM_TYPE_BLUE_STAR    = api.SYSTEM_TYPE_BLUE_STAR
+	SYSTEM_TYPE_YOUNG_STAR   = api.SYSTEM_TYPE_YOUNG_STAR
+	SYSTEM_TYPE_WHITE_DWARF  = api.SYSTEM_TYPE_WHITE_DWARF
+	SYSTEM_TYPE_BLACK_HOLE   = api.SYSTEM_TYPE_BLACK_HOLE
+	SYSTEM_TYPE_HYPERGIANT   = api.SYSTEM_TYPE_HYPERGIANT
+	SYSTEM_TYPE_NEBULA       = api.SYSTEM_TYPE_NEBULA
+	SYSTEM_TYPE_UNSTABLE     = api.SYSTEM_TYPE_UNSTABLE
+)
+
+// Every SystemType this package knows about.
+func AllSystemTypes() []SystemType {
+	return api.AllSystemTypes()
+}
+
+// The good's symbol.
+type TradeSymbol = api.TradeSymbol
+
+const (
+	TRADE_SYMBOL_PRECIOUS_STONES            = api.TRADE_SYMBOL_PRECIOUS_STONES
+	TRADE_SYMBOL_QUARTZ_SAND                = api.TRADE_SYMBOL_QUARTZ_SAND
+	TRADE_SYMBOL_SILICON_CRYSTALS           = api.TRADE_SYMBOL_SILICON_CRYSTALS
+	TRADE_SYMBOL_AMMONIA_ICE                = api.TRADE_SYMBOL_AMMONIA_ICE
+	TRADE_SYMBOL_LIQUID_HYDROGEN            = api.TRADE_SYMBOL_LIQUID_HYDROGEN
+	TRADE_SYMBOL_LIQUID_NITROGEN            = api.TRADE_SYMBOL_LIQUID_NITROGEN
+	TRADE_SYMBOL_ICE_WATER                  = api.TRADE_SYMBOL_ICE_WATER
+	TRADE_SYMBOL_EXOTIC_MATTER              = api.TRADE_SYMBOL_EXOTIC_MATTER
+	TRADE_SYMBOL_ADVANCED_CIRCUITRY         = api.TRADE_SYMBOL_ADVANCED_CIRCUITRY
+	TRADE_SYMBOL_GRAVITON_EMITTERS          = api.TRADE_SYMBOL_GRAVITON_EMITTERS
+	TRADE_SYMBOL_IRON                       = api.TRADE_SYMBOL_IRON
+	TRADE_SYMBOL_IRON_ORE                   = api.TRADE_SYMBOL_IRON_ORE
+	TRADE_SYMBOL_COPPER                     = api.TRADE_SYMBOL_COPPER
+	TRADE_SYMBOL_COPPER_ORE                 = api.TRADE_SYMBOL_COPPER_ORE
+	TRADE_SYMBOL_ALUMINUM                   = api.TRADE_SYMBOL_ALUMINUM
+	TRADE_SYMBOL_ALUMINUM_ORE               = api.TRADE_SYMBOL_ALUMINUM_ORE
+	TRADE_SYMBOL_SILVER                     = api.TRADE_SYMBOL_SILVER
+	TRADE_SYMBOL_SILVER_ORE                 = api.TRADE_SYMBOL_SILVER_ORE
+	TRADE_SYMBOL_GOLD                       = api.TRADE_SYMBOL_GOLD
+	TRADE_SYMBOL_GOLD_ORE                   = api.TRADE_SYMBOL_GOLD_ORE
+	TRADE_SYMBOL_PLATINUM                   = api.TRADE_SYMBOL_PLATINUM
+	TRADE_SYMBOL_PLATINUM_ORE               = api.TRADE_SYMBOL_PLATINUM_ORE
+	TRADE_SYMBOL_DIAMONDS                   = api.TRADE_SYMBOL_DIAMONDS
+	TRADE_SYMBOL_URANITE                    = api.TRADE_SYMBOL_URANITE
+	TRADE_SYMBOL_URANITE_ORE                = api.TRADE_SYMBOL_URANITE_ORE
+	TRADE_SYMBOL_MERITIUM                   = api.TRADE_SYMBOL_MERITIUM
+	TRADE_SYMBOL_MERITIUM_ORE               = api.TRADE_SYMBOL_MERITIUM_ORE
+	TRADE_SYMBOL_HYDROCARBON                = api.TRADE_SYMBOL_HYDROCARBON
+	TRADE_SYMBOL_ANTIMATTER                 = api.TRADE_SYMBOL_ANTIMATTER
+	TRADE_SYMBOL_FAB_MATS                   = api.TRADE_SYMBOL_FAB_MATS
+	TRADE_SYMBOL_FERTILIZERS                = api.TRADE_SYMBOL_FERTILIZERS
+	TRADE_SYMBOL_FABRICS                    = api.TRADE_SYMBOL_FABRICS
+	TRADE_SYMBOL_FOOD                       = api.TRADE_SYMBOL_FOOD
+	TRADE_SYMBOL_JEWELRY                    = api.TRADE_SYMBOL_JEWELRY
+	TRADE_SYMBOL_MACHINERY                  = api.TRADE_SYMBOL_MACHINERY
+	TRADE_SYMBOL_FIREARMS                   = api.TRADE_SYMBOL_FIREARMS
+	TRADE_SYMBOL_ASSAULT_RIFLES             = api.TRADE_SYMBOL_ASSAULT_RIFLES
+	TRADE_SYMBOL_MILITARY_EQUIPMENT         = api.TRADE_SYMBOL_MILITARY_EQUIPMENT
+	TRADE_SYMBOL_EXPLOSIVES                 = api.TRADE_SYMBOL_EXPLOSIVES
+	TRADE_SYMBOL_LAB_INSTRUMENTS            = api.TRADE_SYMBOL_LAB_INSTRUMENTS
+	TRADE_SYMBOL_AMMUNITION                 = api.TRADE_SYMBOL_AMMUNITION
+	TRADE_SYMBOL_ELECTRONICS                = api.TRADE_SYMBOL_ELECTRONICS
+	TRADE_SYMBOL_SHIP_PLATING               = api.TRADE_SYMBOL_SHIP_PLATING
+	TRADE_SYMBOL_SHIP_PARTS                 = api.TRADE_SYMBOL_SHIP_PARTS
+	TRADE_SYMBOL_EQUIPMENT                  = api.TRADE_SYMBOL_EQUIPMENT
+	TRADE_SYMBOL_FUEL                       = api.TRADE_SYMBOL_FUEL
+	TRADE_SYMBOL_MEDICINE                   = api.TRADE_SYMBOL_MEDICINE
+	TRADE_SYMBOL_DRUGS                      = api.TRADE_SYMBOL_DRUGS
+	TRADE_SYMBOL_CLOTHING                   = api.TRADE_SYMBOL_CLOTHING
+	TRADE_SYMBOL_MICROPROCESSORS            = api.TRADE_SYMBOL_MICROPROCESSORS
+	TRADE_SYMBOL_PLASTICS                   = api.TRADE_SYMBOL_PLASTICS
+	TRADE_SYMBOL_POLYNUCLEOTIDES            = api.TRADE_SYMBOL_POLYNUCLEOTIDES
+	TRADE_SYMBOL_BIOCOMPOSITES              = api.TRADE_SYMBOL_BIOCOMPOSITES
+	TRADE_SYMBOL_QUANTUM_STABILIZERS        = api.TRADE_SYMBOL_QUANTUM_STABILIZERS
+	TRADE_SYMBOL_NANOBOTS                   = api.TRADE_SYMBOL_NANOBOTS
+	TRADE_SYMBOL_AI_MAINFRAMES              = api.TRADE_SYMBOL_AI_MAINFRAMES
+	TRADE_SYMBOL_QUANTUM_DRIVES             = api.TRADE_SYMBOL_QUANTUM_DRIVES
+	TRADE_SYMBOL_ROBOTIC_DRONES             = api.TRADE_SYMBOL_ROBOTIC_DRONES
+	TRADE_SYMBOL_CYBER_IMPLANTS             = api.TRADE_SYMBOL_CYBER_IMPLANTS
+	TRADE_SYMBOL_GENE_THERAPEUTICS          = api.TRADE_SYMBOL_GENE_THERAPEUTICS
+	TRADE_SYMBOL_NEURAL_CHIPS               = api.TRADE_SYMBOL_NEURAL_CHIPS
+	TRADE_SYMBOL_MOOD_REGULATORS            = api.TRADE_SYMBOL_MOOD_REGULATORS
+	TRADE_SYMBOL_VIRAL_AGENTS               = api.TRADE_SYMBOL_VIRAL_AGENTS
+	TRADE_SYMBOL_MICRO_FUSION_GENERATORS    = api.TRADE_SYMBOL_MICRO_FUSION_GENERATORS
+	TRADE_SYMBOL_SUPERGRAINS                = api.TRADE_SYMBOL_SUPERGRAINS
+	TRADE_SYMBOL_LASER_RIFLES               = api.TRADE_SYMBOL_LASER_RIFLES
+	TRADE_SYMBOL_HOLOGRAPHICS               = api.TRADE_SYMBOL_HOLOGRAPHICS
+	TRADE_SYMBOL_SHIP_SALVAGE               = api.TRADE_SYMBOL_SHIP_SALVAGE
+	TRADE_SYMBOL_RELIC_TECH                 = api.TRADE_SYMBOL_RELIC_TECH
+	TRADE_SYMBOL_NOVEL_LIFEFORMS            = api.TRADE_SYMBOL_NOVEL_LIFEFORMS
+	TRADE_SYMBOL_BOTANICAL_SPECIMENS        = api.TRADE_SYMBOL_BOTANICAL_SPECIMENS
+	TRADE_SYMBOL_CULTURAL_ARTIFACTS         = api.TRADE_SYMBOL_CULTURAL_ARTIFACTS
+	TRADE_SYMBOL_FRAME_PROBE                = api.TRADE_SYMBOL_FRAME_PROBE
+	TRADE_SYMBOL_FRAME_DRONE                = api.TRADE_SYMBOL_FRAME_DRONE
+	TRADE_SYMBOL_FRAME_INTERCEPTOR          = api.TRADE_SYMBOL_FRAME_INTERCEPTOR
+	TRADE_SYMBOL_FRAME_RACER                = api.TRADE_SYMBOL_FRAME_RACER
+	TRADE_SYMBOL_FRAME_FIGHTER              = api.TRADE_SYMBOL_FRAME_FIGHTER
+	TRADE_SYMBOL_FRAME_FRIGATE              = api.TRADE_SYMBOL_FRAME_FRIGATE
+	TRADE_SYMBOL_FRAME_SHUTTLE              = api.TRADE_SYMBOL_FRAME_SHUTTLE
+	TRADE_SYMBOL_FRAME_EXPLORER             = api.TRADE_SYMBOL_FRAME_EXPLORER
+	TRADE_SYMBOL_FRAME_MINER                = api.TRADE_SYMBOL_FRAME_MINER
+	TRADE_SYMBOL_FRAME_LIGHT_FREIGHTER      = api.TRADE_SYMBOL_FRAME_LIGHT_FREIGHTER
+	TRADE_SYMBOL_FRAME_HEAVY_FREIGHTER      = api.TRADE_SYMBOL_FRAME_HEAVY_FREIGHTER
+	TRADE_SYMBOL_FRAME_TRANSPORT            = api.TRADE_SYMBOL_FRAME_TRANSPORT
+	TRADE_SYMBOL_FRAME_DESTROYER            = api.TRADE_SYMBOL_FRAME_DESTROYER
+	TRADE_SYMBOL_FRAME_CRUISER              = api.TRADE_SYMBOL_FRAME_CRUISER
+	TRADE_SYMBOL_FRAME_CARRIER              = api.TRADE_SYMBOL_FRAME_CARRIER
+	TRADE_SYMBOL_REACTOR_SOLAR_I            = api.TRADE_SYMBOL_REACTOR_SOLAR_I
+	TRADE_SYMBOL_REACTOR_FUSION_I           = api.TRADE_SYMBOL_REACTOR_FUSION_I
+	TRADE_SYMBOL_REACTOR_FISSION_I          = api.TRADE_SYMBOL_REACTOR_FISSION_I
+	TRADE_SYMBOL_REACTOR_CHEMICAL_I         = api.TRADE_SYMBOL_REACTOR_CHEMICAL_I
+	TRADE_SYMBOL_REACTOR_ANTIMATTER_I       = api.TRADE_SYMBOL_REACTOR_ANTIMATTER_I
+	TRADE_SYMBOL_ENGINE_IMPULSE_DRIVE_I     = api.TRADE_SYMBOL_ENGINE_IMPULSE_DRIVE_I
+	TRADE_SYMBOL_ENGINE_ION_DRIVE_I         = api.TRADE_SYMBOL_ENGINE_ION_DRIVE_I
+	TRADE_SYMBOL_ENGINE_ION_DRIVE_II        = api.TRADE_SYMBOL_ENGINE_ION_DRIVE_II
+	TRADE_SYMBOL_ENGINE_HYPER_DRIVE_I       = api.TRADE_SYMBOL_ENGINE_HYPER_DRIVE_I
+	TRADE_SYMBOL_MODULE_MINERAL_PROCESSOR_I = api.TRADE_SYMBOL_MODULE_MINERAL_PROCESSOR_I
+	TRADE_SYMBOL_MODULE_GAS_PROCESSOR_I     = api.TRADE_SYMBOL_MODULE_GAS_PROCESSOR_I
+	TRADE_SYMBOL_MODULE_CARGO_HOLD_I        = api.TRADE_SYMBOL_MODULE_CARGO_HOLD_I
+	TRADE_SYMBOL_MODULE_CARGO_HOLD_II       = api.TRADE_SYMBOL_MODULE_CARGO_HOLD_II
+	TRADE_SYMBOL_MODULE_CARGO_HOLD_III      = api.TRADE_SYMBOL_MODULE_CARGO_HOLD_III
+	TRADE_SYMBOL_MODULE_CREW_QUARTERS_I     = api.TRADE_SYMBOL_MODULE_CREW_QUARTERS_I
+	TRADE_SYMBOL_MODULE_ENVOY_QUARTERS_I    = api.TRADE_SYMBOL_MODULE_ENVOY_QUARTERS_I
+	TRADE_SYMBOL_MODULE_PASSENGER_CABIN_I   = api.TRADE_SYMBOL_MODULE_PASSENGER_CABIN_I
+	TRADE_SYMBOL_MODULE_MICRO_REFINERY_I    = api.TRADE_SYMBOL_MODULE_MICRO_REFINERY_I
+	TRADE_SYMBOL_MODULE_SCIENCE_LAB_I       = api.TRADE_SYMBOL_MODULE_SCIENCE_LAB_I
+	TRADE_SYMBOL_MODULE_JUMP_DRIVE_I        = api.TRADE_SYMBOL_MODULE_JUMP_DRIVE_I
+	TRADE_SYMBOL_MODULE_JUMP_DRIVE_II       = api.TRADE_SYMBOL_MODULE_JUMP_DRIVE_II
+	TRADE_SYMBOL_MODULE_JUMP_DRIVE_III      = api.TRADE_SYMBOL_MODULE_JUMP_DRIVE_III
+	TRADE_SYMBOL_MODULE_WARP_DRIVE_I        = api.TRADE_SYMBOL_MODULE_WARP_DRIVE_I
+	TRADE_SYMBOL_MODULE_WARP_DRIVE_II       = api.TRADE_SYMBOL_MODULE_WARP_DRIVE_II
+	TRADE_SYMBOL_MODULE_WARP_DRIVE_III      = api.TRADE_SYMBOL_MODULE_WARP_DRIVE_III
+	TRADE_SYMBOL_MODULE_SHIELD_GENERATOR_I  = api.TRADE_SYMBOL_MODULE_SHIELD_GENERATOR_I
+	TRADE_SYMBOL_MODULE_SHIELD_GENERATOR_II = api.TRADE_SYMBOL_MODULE_SHIELD_GENERATOR_II
+	TRADE_SYMBOL_MODULE_ORE_REFINERY_I      = api.TRADE_SYMBOL_MODULE_ORE_REFINERY_I
+	TRADE_SYMBOL_MODULE_FUEL_REFINERY_I     = api.TRADE_SYMBOL_MODULE_FUEL_REFINERY_I
+	TRADE_SYMBOL_MOUNT_GAS_SIPHON_I         = api.TRADE_SYMBOL_MOUNT_GAS_SIPHON_I
+	TRADE_SYMBOL_MOUNT_GAS_SIPHON_II        = api.TRADE_SYMBOL_MOUNT_GAS_SIPHON_II
+	TRADE_SYMBOL_MOUNT_GAS_SIPHON_III       = api.TRADE_SYMBOL_MOUNT_GAS_SIPHON_III
+	TRADE_SYMBOL_MOUNT_SURVEYOR_I           = api.TRADE_SYMBOL_MOUNT_SURVEYOR_I
+	TRADE_SYMBOL_MOUNT_SURVEYOR_II          = api.TRADE_SYMBOL_MOUNT_SURVEYOR_II
+	TRADE_SYMBOL_MOUNT_SURVEYOR_III         = api.TRADE_SYMBOL_MOUNT_SURVEYOR_III
+	TRADE_SYMBOL_MOUNT_SENSOR_ARRAY_I       = api.TRADE_SYMBOL_MOUNT_SENSOR_ARRAY_I
+	TRADE_SYMBOL_MOUNT_SENSOR_ARRAY_II      = api.TRADE_SYMBOL_MOUNT_SENSOR_ARRAY_II
+	TRADE_SYMBOL_MOUNT_SENSOR_ARRAY_III     = api.TRADE_SYMBOL_MOUNT_SENSOR_ARRAY_III
+	TRADE_SYMBOL_MOUNT_MINING_LASER_I       = api.TRADE_SYMBOL_MOUNT_MINING_LASER_I
+	TRADE_SYMBOL_MOUNT_MINING_LASER_II      = api.TRADE_SYMBOL_MOUNT_MINING_LASER_II
+	TRADE_SYMBOL_MOUNT_MINING_LASER_III     = api.TRADE_SYMBOL_MOUNT_MINING_LASER_III
+	TRADE_SYMBOL_MOUNT_LASER_CANNON_I       = api.TRADE_SYMBOL_MOUNT_LASER_CANNON_I
+	TRADE_SYMBOL_MOUNT_MISSILE_LAUNCHER_I   = api.TRADE_SYMBOL_MOUNT_MISSILE_LAUNCHER_I
+	TRADE_SYMBOL_MOUNT_TURRET_I             = api.TRADE_SYMBOL_MOUNT_TURRET_I
+	TRADE_SYMBOL_SHIP_PROBE                 = api.TRADE_SYMBOL_SHIP_PROBE
+	TRADE_SYMBOL_SHIP_MINING_DRONE          = api.TRADE_SYMBOL_SHIP_MINING_DRONE
+	TRADE_SYMBOL_SHIP_SIPHON_DRONE          = api.TRADE_SYMBOL_SHIP_SIPHON_DRONE
+	TRADE_SYMBOL_SHIP_INTERCEPTOR           = api.TRADE_SYMBOL_SHIP_INTERCEPTOR
+	TRADE_SYMBOL_SHIP_LIGHT_HAULER          = api.TRADE_SYMBOL_SHIP_LIGHT_HAULER
+	TRADE_SYMBOL_SHIP_COMMAND_FRIGATE       = api.TRADE_SYMBOL_SHIP_COMMAND_FRIGATE
+	TRADE_SYMBOL_SHIP_EXPLORER              = api.TRADE_SYMBOL_SHIP_EXPLORER
+	TRADE_SYMBOL_SHIP_HEAVY_FREIGHTER       = api.TRADE_SYMBOL_SHIP_HEAVY_FREIGHTER
+	TRADE_SYMBOL_SHIP_LIGHT_SHUTTLE         = api.TRADE_SYMBOL_SHIP_LIGHT_SHUTTLE
+	TRADE_SYMBOL_SHIP_ORE_HOUND             = api.TRADE_SYMBOL_SHIP_ORE_HOUND
+	TRADE_SYMBOL_SHIP_REFINING_FREIGHTER    = api.TRADE_SYMBOL_SHIP_REFINING_FREIGHTER
+	TRADE_SYMBOL_SHIP_SURVEYOR              = api.TRADE_SYMBOL_SHIP_SURVEYOR
+)
+
+// Every TradeSymbol this package knows about.
+func AllTradeSymbols() []TradeSymbol {
+	return api.AllTradeSymbols()
+}
+
+// The unique identifier of the modifier.
+type WaypointModifierSymbol = api.WaypointModifierSymbol
+
+const (
+	WAYPOINT_MODIFIER_STRIPPED       = api.WAYPOINT_MODIFIER_STRIPPED
+	WAYPOINT_MODIFIER_UNSTABLE       = api.WAYPOINT_MODIFIER_UNSTABLE
+	WAYPOINT_MODIFIER_RADIATION_LEAK = api.WAYPOINT_MODIFIER_RADIATION_LEAK
+	WAYPOINT_MODIFIER_CRITICAL_LIMIT = api.WAYPOINT_MODIFIER_CRITICAL_LIMIT
+	WAYPOINT_MODIFIER_CIVIL_UNREST   = api.WAYPOINT_MODIFIER_CIVIL_UNREST
+)
+
+// Every WaypointModifierSymbol this package knows about.
+func AllWaypointModifierSymbols() []WaypointModifierSymbol {
+	return api.AllWaypointModifierSymbols()
+}
+
+// The unique identifier of the trait.
+type WaypointTraitSymbol = api.WaypointTraitSymbol
+
+const (
+	WAYPOINT_TRAIT_UNCHARTED               = api.WAYPOINT_TRAIT_UNCHARTED
+	WAYPOINT_TRAIT_UNDER_CONSTRUCTION      = api.WAYPOINT_TRAIT_UNDER_CONSTRUCTION
+	WAYPOINT_TRAIT_MARKETPLACE             = api.WAYPOINT_TRAIT_MARKETPLACE
+	WAYPOINT_TRAIT_SHIPYARD                = api.WAYPOINT_TRAIT_SHIPYARD
+	WAYPOINT_TRAIT_OUTPOST                 = api.WAYPOINT_TRAIT_OUTPOST
+	WAYPOINT_TRAIT_SCATTERED_SETTLEMENTS   = api.WAYPOINT_TRAIT_SCATTERED_SETTLEMENTS
+	WAYPOINT_TRAIT_SPRAWLING_CITIES        = api.WAYPOINT_TRAIT_SPRAWLING_CITIES
+	WAYPOINT_TRAIT_MEGA_STRUCTURES         = api.WAYPOINT_TRAIT_MEGA_STRUCTURES
+	WAYPOINT_TRAIT_PIRATE_BASE             = api.WAYPOINT_TRAIT_PIRATE_BASE
+	WAYPOINT_TRAIT_OVERCROWDED             = api.WAYPOINT_TRAIT_OVERCROWDED
+	WAYPOINT_TRAIT_HIGH_TECH               = api.WAYPOINT_TRAIT_HIGH_TECH
+	WAYPOINT_TRAIT_CORRUPT                 = api.WAYPOINT_TRAIT_CORRUPT
+	WAYPOINT_TRAIT_BUREAUCRATIC            = api.WAYPOINT_TRAIT_BUREAUCRATIC
+	WAYPOINT_TRAIT_TRADING_HUB             = api.WAYPOINT_TRAIT_TRADING_HUB
+	WAYPOINT_TRAIT_INDUSTRIAL              = api.WAYPOINT_TRAIT_INDUSTRIAL
+	WAYPOINT_TRAIT_BLACK_MARKET            = api.WAYPOINT_TRAIT_BLACK_MARKET
+	WAYPOINT_TRAIT_RESEARCH_FACILITY       = api.WAYPOINT_TRAIT_RESEARCH_FACILITY
+	WAYPOINT_TRAIT_MILITARY_BASE           = api.WAYPOINT_TRAIT_MILITARY_BASE
+	WAYPOINT_TRAIT_SURVEILLANCE_OUTPOST    = api.WAYPOINT_TRAIT_SURVEILLANCE_OUTPOST
+	WAYPOINT_TRAIT_EXPLORATION_OUTPOST     = api.WAYPOINT_TRAIT_EXPLORATION_OUTPOST
+	WAYPOINT_TRAIT_MINERAL_DEPOSITS        = api.WAYPOINT_TRAIT_MINERAL_DEPOSITS
+	WAYPOINT_TRAIT_COMMON_METAL_DEPOSITS   = api.WAYPOINT_TRAIT_COMMON_METAL_DEPOSITS
+	WAYPOINT_TRAIT_PRECIOUS_METAL_DEPOSITS = api.WAYPOINT_TRAIT_PRECIOUS_METAL_DEPOSITS
+	WAYPOINT_TRAIT_RARE_METAL_DEPOSITS     = api.WAYPOINT_TRAIT_RARE_METAL_DEPOSITS
+	WAYPOINT_TRAIT_METHANE_POOLS           = api.WAYPOINT_TRAIT_METHANE_POOLS
+	WAYPOINT_TRAIT_ICE_CRYSTALS            = api.WAYPOINT_TRAIT_ICE_CRYSTALS
+	WAYPOINT_TRAIT_EXPLOSIVE_GASES         = api.WAYPOINT_TRAIT_EXPLOSIVE_GASES
+	WAYPOINT_TRAIT_STRONG_MAGNETOSPHERE    = api.WAYPOINT_TRAIT_STRONG_MAGNETOSPHERE
+	WAYPOINT_TRAIT_VIBRANT_AURORAS         = api.WAYPOINT_TRAIT_VIBRANT_AURORAS
+	WAYPOINT_TRAIT_SALT_FLATS              = api.WAYPOINT_TRAIT_SALT_FLATS
+	WAYPOINT_TRAIT_CANYONS                 = api.WAYPOINT_TRAIT_CANYONS
+	WAYPOINT_TRAIT_PERPETUAL_DAYLIGHT      = api.WAYPOINT_TRAIT_PERPETUAL_DAYLIGHT
+	WAYPOINT_TRAIT_PERPETUAL_OVERCAST      = api.WAYPOINT_TRAIT_PERPETUAL_OVERCAST
+	WAYPOINT_TRAIT_DRY_SEABEDS             = api.WAYPOINT_TRAIT_DRY_SEABEDS
+	WAYPOINT_TRAIT_MAGMA_SEAS              = api.WAYPOINT_TRAIT_MAGMA_SEAS
+	WAYPOINT_TRAIT_SUPERVOLCANOES          = api.WAYPOINT_TRAIT_SUPERVOLCANOES
+	WAYPOINT_TRAIT_ASH_CLOUDS              = api.WAYPOINT_TRAIT_ASH_CLOUDS
+	WAYPOINT_TRAIT_VAST_RUINS              = api.WAYPOINT_TRAIT_VAST_RUINS
+	WAYPOINT_TRAIT_MUTATED_FLORA           = api.WAYPOINT_TRAIT_MUTATED_FLORA
+	WAYPOINT_TRAIT_TERRAFORMED             = api.WAYPOINT_TRAIT_TERRAFORMED
+	WAYPOINT_TRAIT_EXTREME_TEMPERATURES    = api.WAYPOINT_TRAIT_EXTREME_TEMPERATURES
+	WAYPOINT_TRAIT_EXTREME_PRESSURE        = api.WAYPOINT_TRAIT_EXTREME_PRESSURE
+	WAYPOINT_TRAIT_DIVERSE_LIFE            = api.WAYPOINT_TRAIT_DIVERSE_LIFE
+	WAYPOINT_TRAIT_SCARCE_LIFE             = api.WAYPOINT_TRAIT_SCARCE_LIFE
+	WAYPOINT_TRAIT_FOSSILS                 = api.WAYPOINT_TRAIT_FOSSILS
+	WAYPOINT_TRAIT_WEAK_GRAVITY            = api.WAYPOINT_TRAIT_WEAK_GRAVITY
+	WAYPOINT_TRAIT_STRONG_GRAVITY          = api.WAYPOINT_TRAIT_STRONG_GRAVITY
+	WAYPOINT_TRAIT_CRUSHING_GRAVITY        = api.WAYPOINT_TRAIT_CRUSHING_GRAVITY
+	WAYPOINT_TRAIT_TOXIC_ATMOSPHERE        = api.WAYPOINT_TRAIT_TOXIC_ATMOSPHERE
+	WAYPOINT_TRAIT_CORROSIVE_ATMOSPHERE    = api.WAYPOINT_TRAIT_CORROSIVE_ATMOSPHERE
+	WAYPOINT_TRAIT_BREATHABLE_ATMOSPHERE   = api.WAYPOINT_TRAIT_BREATHABLE_ATMOSPHERE
+	WAYPOINT_TRAIT_THIN_ATMOSPHERE         = api.WAYPOINT_TRAIT_THIN_ATMOSPHERE
+	WAYPOINT_TRAIT_JOVIAN                  = api.WAYPOINT_TRAIT_JOVIAN
+	WAYPOINT_TRAIT_ROCKY                   = api.WAYPOINT_TRAIT_ROCKY
+	WAYPOINT_TRAIT_VOLCANIC                = api.WAYPOINT_TRAIT_VOLCANIC
+	WAYPOINT_TRAIT_FROZEN                  = api.WAYPOINT_TRAIT_FROZEN
+	WAYPOINT_TRAIT_SWAMP                   = api.WAYPOINT_TRAIT_SWAMP
+	WAYPOINT_TRAIT_BARREN                  = api.WAYPOINT_TRAIT_BARREN
+	WAYPOINT_TRAIT_TEMPERATE               = api.WAYPOINT_TRAIT_TEMPERATE
+	WAYPOINT_TRAIT_JUNGLE                  = api.WAYPOINT_TRAIT_JUNGLE
+	WAYPOINT_TRAIT_OCEAN                   = api.WAYPOINT_TRAIT_OCEAN
+	WAYPOINT_TRAIT_RADIOACTIVE             = api.WAYPOINT_TRAIT_RADIOACTIVE
+	WAYPOINT_TRAIT_MICRO_GRAVITY_ANOMALIES = api.WAYPOINT_TRAIT_MICRO_GRAVITY_ANOMALIES
+	WAYPOINT_TRAIT_DEBRIS_CLUSTER          = api.WAYPOINT_TRAIT_DEBRIS_CLUSTER
+	WAYPOINT_TRAIT_DEEP_CRATERS            = api.WAYPOINT_TRAIT_DEEP_CRATERS
+	WAYPOINT_TRAIT_SHALLOW_CRATERS         = api.WAYPOINT_TRAIT_SHALLOW_CRATERS
+	WAYPOINT_TRAIT_UNSTABLE_COMPOSITION    = api.WAYPOINT_TRAIT_UNSTABLE_COMPOSITION
+	WAYPOINT_TRAIT_HOLLOWED_INTERIOR       = api.WAYPOINT_TRAIT_HOLLOWED_INTERIOR
+	WAYPOINT_TRAIT_STRIPPED                = api.WAYPOINT_TRAIT_STRIPPED
+)
+
+// Every WaypointTraitSymbol this package knows about.
+func AllWaypointTraitSymbols() []WaypointTraitSymbol {
+	return api.AllWaypointTraitSymbols()
+}
+
+// The type of waypoint.
+type WaypointType = api.WaypointType
+
+const (
+	WAYPOINT_TYPE_PLANET                  = api.WAYPOINT_TYPE_PLANET
+	WAYPOINT_TYPE_GAS_GIANT               = api.WAYPOINT_TYPE_GAS_GIANT
+	WAYPOINT_TYPE_MOON                    = api.WAYPOINT_TYPE_MOON
+	WAYPOINT_TYPE_ORBITAL_STATION         = api.WAYPOINT_TYPE_ORBITAL_STATION
+	WAYPOINT_TYPE_JUMP_GATE               = api.WAYPOINT_TYPE_JUMP_GATE
+	WAYPOINT_TYPE_ASTEROID_FIELD          = api.WAYPOINT_TYPE_ASTEROID_FIELD
+	WAYPOINT_TYPE_ASTEROID                = api.WAYPOINT_TYPE_ASTEROID
+	WAYPOINT_TYPE_ENGINEERED_ASTEROID     = api.WAYPOINT_TYPE_ENGINEERED_ASTEROID
+	WAYPOINT_TYPE_ASTEROID_BASE           = api.WAYPOINT_TYPE_ASTEROID_BASE
+	WAYPOINT_TYPE_NEBULA                  = api.WAYPOINT_TYPE_NEBULA
+	WAYPOINT_TYPE_DEBRIS_FIELD            = api.WAYPOINT_TYPE_DEBRIS_FIELD
+	WAYPOINT_TYPE_GRAVITY_WELL            = api.WAYPOINT_TYPE_GRAVITY_WELL
+	WAYPOINT_TYPE_ARTIFICIAL_GRAVITY_WELL = api.WAYPOINT_TYPE_ARTIFICIAL_GRAVITY_WELL
+	WAYPOINT_TYPE_FUEL_STATION            = api.WAYPOINT_TYPE_FUEL_STATION
+)
+
+// Every WaypointType this package knows about.
+func AllWaypointTypes() []WaypointType {
+	return api.AllWaypointTypes()
+}
+
+// Type of contract.
+type ContractType = api.ContractType
+
+const (
+	CONTRACT_TYPE_PROCUREMENT = api.CONTRACT_TYPE_PROCUREMENT
+	CONTRACT_TYPE_TRANSPORT   = api.CONTRACT_TYPE_TRANSPORT
+	CONTRACT_TYPE_SHUTTLE     = api.CONTRACT_TYPE_SHUTTLE
+)
+
+// Every ContractType this package knows about.
+func AllContractTypes() []ContractType {
+	return api.AllContractTypes()
+}
+
+// The type of trade good (export, import, or exchange).
+type TradeGoodType = api.TradeGoodType
+
+const (
+	TRADE_GOOD_TYPE_EXPORT   = api.TRADE_GOOD_TYPE_EXPORT
+	TRADE_GOOD_TYPE_IMPORT   = api.TRADE_GOOD_TYPE_IMPORT
+	TRADE_GOOD_TYPE_EXCHANGE = api.TRADE_GOOD_TYPE_EXCHANGE
+)
+
+// Every TradeGoodType this package knows about.
+func AllTradeGoodTypes() []TradeGoodType {
+	return api.AllTradeGoodTypes()
+}
+
+// The type of transaction.
+type TransactionType = api.TransactionType
+
+const (
+	TRANSACTION_TYPE_PURCHASE = api.TRANSACTION_TYPE_PURCHASE
+	TRANSACTION_TYPE_SELL     = api.TRANSACTION_TYPE_SELL
+)
+
+// Every TransactionType this package knows about.
+func AllTransactionTypes() []TransactionType {
+	return api.AllTransactionTypes()
+}
+
+type ShipConditionEventComponent = api.ShipConditionEventComponent
+
+const (
+	SHIP_CONDITION_EVENT_COMPONENT_FRAME   = api.SHIP_CONDITION_EVENT_COMPONENT_FRAME
+	SHIP_CONDITION_EVENT_COMPONENT_REACTOR = api.SHIP_CONDITION_EVENT_COMPONENT_REACTOR
+	SHIP_CONDITION_EVENT_COMPONENT_ENGINE  = api.SHIP_CONDITION_EVENT_COMPONENT_ENGINE
+)
+
+// Every ShipConditionEventComponent this package knows about.
+func AllShipConditionEventComponents() []ShipConditionEventComponent {
+	return api.AllShipConditionEventComponents()
+}
+
+type ShipConditionEventSymbol = api.ShipConditionEventSymbol
+
+const (
+	SHIP_CONDITION_EVENT_SYMBOL_REACTOR_OVERLOAD                   = api.SHIP_CONDITION_EVENT_SYMBOL_REACTOR_OVERLOAD
+	SHIP_CONDITION_EVENT_SYMBOL_ENERGY_SPIKE_FROM_MINERAL          = api.SHIP_CONDITION_EVENT_SYMBOL_ENERGY_SPIKE_FROM_MINERAL
+	SHIP_CONDITION_EVENT_SYMBOL_SOLAR_FLARE_INTERFERENCE           = api.SHIP_CONDITION_EVENT_SYMBOL_SOLAR_FLARE_INTERFERENCE
+	SHIP_CONDITION_EVENT_SYMBOL_COOLANT_LEAK                       = api.SHIP_CONDITION_EVENT_SYMBOL_COOLANT_LEAK
+	SHIP_CONDITION_EVENT_SYMBOL_POWER_DISTRIBUTION_FLUCTUATION     = api.SHIP_CONDITION_EVENT_SYMBOL_POWER_DISTRIBUTION_FLUCTUATION
+	SHIP_CONDITION_EVENT_SYMBOL_MAGNETIC_FIELD_DISRUPTION          = api.SHIP_CONDITION_EVENT_SYMBOL_MAGNETIC_FIELD_DISRUPTION
+	SHIP_CONDITION_EVENT_SYMBOL_HULL_MICROMETEORITE_STRIKES        = api.SHIP_CONDITION_EVENT_SYMBOL_HULL_MICROMETEORITE_STRIKES
+	SHIP_CONDITION_EVENT_SYMBOL_STRUCTURAL_STRESS_FRACTURES        = api.SHIP_CONDITION_EVENT_SYMBOL_STRUCTURAL_STRESS_FRACTURES
+	SHIP_CONDITION_EVENT_SYMBOL_CORROSIVE_MINERAL_CONTAMINATION    = api.SHIP_CONDITION_EVENT_SYMBOL_CORROSIVE_MINERAL_CONTAMINATION
+	SHIP_CONDITION_EVENT_SYMBOL_THERMAL_EXPANSION_MISMATCH         = api.SHIP_CONDITION_EVENT_SYMBOL_THERMAL_EXPANSION_MISMATCH
+	SHIP_CONDITION_EVENT_SYMBOL_VIBRATION_DAMAGE_FROM_DRILLING     = api.SHIP_CONDITION_EVENT_SYMBOL_VIBRATION_DAMAGE_FROM_DRILLING
+	SHIP_CONDITION_EVENT_SYMBOL_ELECTROMAGNETIC_FIELD_INTERFERENCE = api.SHIP_CONDITION_EVENT_SYMBOL_ELECTROMAGNETIC_FIELD_INTERFERENCE
+	SHIP_CONDITION_EVENT_SYMBOL_IMPACT_WITH_EXTRACTED_DEBRIS       = api.SHIP_CONDITION_EVENT_SYMBOL_IMPACT_WITH_EXTRACTED_DEBRIS
+	SHIP_CONDITION_EVENT_SYMBOL_FUEL_EFFICIENCY_DEGRADATION        = api.SHIP_CONDITION_EVENT_SYMBOL_FUEL_EFFICIENCY_DEGRADATION
+	SHIP_CONDITION_EVENT_SYMBOL_COOLANT_SYSTEM_AGEING              = api.SHIP_CONDITION_EVENT_SYMBOL_COOLANT_SYSTEM_AGEING
+	SHIP_CONDITION_EVENT_SYMBOL_DUST_MICROABRASIONS                = api.SHIP_CONDITION_EVENT_SYMBOL_DUST_MICROABRASIONS
+	SHIP_CONDITION_EVENT_SYMBOL_THRUSTER_NOZZLE_WEAR               = api.SHIP_CONDITION_EVENT_SYMBOL_THRUSTER_NOZZLE_WEAR
+	SHIP_CONDITION_EVENT_SYMBOL_EXHAUST_PORT_CLOGGING              = api.SHIP_CONDITION_EVENT_SYMBOL_EXHAUST_PORT_CLOGGING
+	SHIP_CONDITION_EVENT_SYMBOL_BEARING_LUBRICATION_FADE           = api.SHIP_CONDITION_EVENT_SYMBOL_BEARING_LUBRICATION_FADE
+	SHIP_CONDITION_EVENT_SYMBOL_SENSOR_CALIBRATION_DRIFT           = api.SHIP_CONDITION_EVENT_SYMBOL_SENSOR_CALIBRATION_DRIFT
+	SHIP_CONDITION_EVENT_SYMBOL_HULL_MICROMETEORITE_DAMAGE         = api.SHIP_CONDITION_EVENT_SYMBOL_HULL_MICROMETEORITE_DAMAGE
+	SHIP_CONDITION_EVENT_SYMBOL_SPACE_DEBRIS_COLLISION             = api.SHIP_CONDITION_EVENT_SYMBOL_SPACE_DEBRIS_COLLISION
+	SHIP_CONDITION_EVENT_SYMBOL_THERMAL_STRESS                     = api.SHIP_CONDITION_EVENT_SYMBOL_THERMAL_STRESS
+	SHIP_CONDITION_EVENT_SYMBOL_VIBRATION_OVERLOAD                 = api.SHIP_CONDITION_EVENT_SYMBOL_VIBRATION_OVERLOAD
+	SHIP_CONDITION_EVENT_SYMBOL_PRESSURE_DIFFERENTIAL_STRESS       = api.SHIP_CONDITION_EVENT_SYMBOL_PRESSURE_DIFFERENTIAL_STRESS
+	SHIP_CONDITION_EVENT_SYMBOL_ELECTROMAGNETIC_SURGE_EFFECTS      = api.SHIP_CONDITION_EVENT_SYMBOL_ELECTROMAGNETIC_SURGE_EFFECTS
+	SHIP_CONDITION_EVENT_SYMBOL_ATMOSPHERIC_ENTRY_HEAT             = api.SHIP_CONDITION_EVENT_SYMBOL_ATMOSPHERIC_ENTRY_HEAT
+)
+
+// Every ShipConditionEventSymbol this package knows about.
+func AllShipConditionEventSymbols() []ShipConditionEventSymbol {
+	return api.AllShipConditionEventSymbols()
+}
+
+// The rotation of crew shifts. A stricter shift improves the ship's performance. A more relaxed shift improves the crew's morale.
+type ShipCrewRotation = api.ShipCrewRotation
+
+const (
+	CREW_ROTATION_STRICT  = api.CREW_ROTATION_STRICT
+	CREW_ROTATION_RELAXED = api.CREW_ROTATION_RELAXED
+)
+
+// Every ShipCrewRotation this package knows about.
+func AllShipCrewRotations() []ShipCrewRotation {
+	return api.AllShipCrewRotations()
+}
+
+// The symbol of the engine.
+type ShipEngineSymbol = api.ShipEngineSymbol
+
+const (
+	ENGINE_IMPULSE_DRIVE_I = api.ENGINE_IMPULSE_DRIVE_I
+	ENGINE_ION_DRIVE_I     = api.ENGINE_ION_DRIVE_I
+	ENGINE_ION_DRIVE_II    = api.ENGINE_ION_DRIVE_II
+	ENGINE_HYPER_DRIVE_I   = api.ENGINE_HYPER_DRIVE_I
+)
+
+// Every ShipEngineSymbol this package knows about.
+func AllShipEngineSymbols() []ShipEngineSymbol {
+	return api.AllShipEngineSymbols()
+}
+
+// Symbol of the frame.
+type ShipFrameSymbol = api.ShipFrameSymbol
+
+const (
+	FRAME_PROBE           = api.FRAME_PROBE
+	FRAME_DRONE           = api.FRAME_DRONE
+	FRAME_INTERCEPTOR     = api.FRAME_INTERCEPTOR
+	FRAME_RACER           = api.FRAME_RACER
+	FRAME_FIGHTER         = api.FRAME_FIGHTER
+	FRAME_FRIGATE         = api.FRAME_FRIGATE
+	FRAME_SHUTTLE         = api.FRAME_SHUTTLE
+	FRAME_EXPLORER        = api.FRAME_EXPLORER
+	FRAME_MINER           = api.FRAME_MINER
+	FRAME_LIGHT_FREIGHTER = api.FRAME_LIGHT_FREIGHTER
+	FRAME_HEAVY_FREIGHTER = api.FRAME_HEAVY_FREIGHTER
+	FRAME_TRANSPORT       = api.FRAME_TRANSPORT
+	FRAME_DESTROYER       = api.FRAME_DESTROYER
+	FRAME_CRUISER         = api.FRAME_CRUISER
+	FRAME_CARRIER         = api.FRAME_CARRIER
+)
+
+// Every ShipFrameSymbol this package knows about.
+func AllShipFrameSymbols() []ShipFrameSymbol {
+	return api.AllShipFrameSymbols()
+}
+
+// The symbol of the module.
+type ShipModuleSymbol = api.ShipModuleSymbol
+
+const (
+	MODULE_MINERAL_PROCESSOR_I = api.MODULE_MINERAL_PROCESSOR_I
+	MODULE_GAS_PROCESSOR_I     = api.MODULE_GAS_PROCESSOR_I
+	MODULE_CARGO_HOLD_I        = api.MODULE_CARGO_HOLD_I
+	MODULE_CARGO_HOLD_II       = api.MODULE_CARGO_HOLD_II
+	MODULE_CARGO_HOLD_III      = api.MODULE_CARGO_HOLD_III
+	MODULE_CREW_QUARTERS_I     = api.MODULE_CREW_QUARTERS_I
+	MODULE_ENVOY_QUARTERS_I    = api.MODULE_ENVOY_QUARTERS_I
+	MODULE_PASSENGER_CABIN_I   = api.MODULE_PASSENGER_CABIN_I
+	MODULE_MICRO_REFINERY_I    = api.MODULE_MICRO_REFINERY_I
+	MODULE_ORE_REFINERY_I      = api.MODULE_ORE_REFINERY_I
+	MODULE_FUEL_REFINERY_I     = api.MODULE_FUEL_REFINERY_I
+	MODULE_SCIENCE_LAB_I       = api.MODULE_SCIENCE_LAB_I
+	MODULE_JUMP_DRIVE_I        = api.MODULE_JUMP_DRIVE_I
+	MODULE_JUMP_DRIVE_II       = api.MODULE_JUMP_DRIVE_II
+	MODULE_JUMP_DRIVE_III      = api.MODULE_JUMP_DRIVE_III
+	MODULE_WARP_DRIVE_I        = api.MODULE_WARP_DRIVE_I
+	MODULE_WARP_DRIVE_II       = api.MODULE_WARP_DRIVE_II
+	MODULE_WARP_DRIVE_III      = api.MODULE_WARP_DRIVE_III
+	MODULE_SHIELD_GENERATOR_I  = api.MODULE_SHIELD_GENERATOR_I
+	MODULE_SHIELD_GENERATOR_II = api.MODULE_SHIELD_GENERATOR_II
+)
+
+// Every ShipModuleSymbol this package knows about.
+func AllShipModuleSymbols() []ShipModuleSymbol {
+	return api.AllShipModuleSymbols()
+}
+
+// Symbol of this mount.
+type ShipMountSymbol = api.ShipMountSymbol
+
+const (
+	MOUNT_GAS_SIPHON_I       = api.MOUNT_GAS_SIPHON_I
+	MOUNT_GAS_SIPHON_II      = api.MOUNT_GAS_SIPHON_II
+	MOUNT_GAS_SIPHON_III     = api.MOUNT_GAS_SIPHON_III
+	MOUNT_SURVEYOR_I         = api.MOUNT_SURVEYOR_I
+	MOUNT_SURVEYOR_II        = api.MOUNT_SURVEYOR_II
+	MOUNT_SURVEYOR_III       = api.MOUNT_SURVEYOR_III
+	MOUNT_SENSOR_ARRAY_I     = api.MOUNT_SENSOR_ARRAY_I
+	MOUNT_SENSOR_ARRAY_II    = api.MOUNT_SENSOR_ARRAY_II
+	MOUNT_SENSOR_ARRAY_III   = api.MOUNT_SENSOR_ARRAY_III
+	MOUNT_MINING_LASER_I     = api.MOUNT_MINING_LASER_I
+	MOUNT_MINING_LASER_II    = api.MOUNT_MINING_LASER_II
+	MOUNT_MINING_LASER_III   = api.MOUNT_MINING_LASER_III
+	MOUNT_LASER_CANNON_I     = api.MOUNT_LASER_CANNON_I
+	MOUNT_MISSILE_LAUNCHER_I = api.MOUNT_MISSILE_LAUNCHER_I
+	MOUNT_TURRET_I           = api.MOUNT_TURRET_I
+)
+
+// Every ShipMountSymbol this package knows about.
+func AllShipMountSymbols() []ShipMountSymbol {
+	return api.AllShipMountSymbols()
+}
+
+// Symbol of the reactor.
+type ShipReactorSymbol = api.ShipReactorSymbol
+
+const (
+	REACTOR_SOLAR_I      = api.REACTOR_SOLAR_I
+	REACTOR_FUSION_I     = api.REACTOR_FUSION_I
+	REACTOR_FISSION_I    = api.REACTOR_FISSION_I
+	REACTOR_CHEMICAL_I   = api.REACTOR_CHEMICAL_I
+	REACTOR_ANTIMATTER_I = api.REACTOR_ANTIMATTER_I
+)
+
+// Every ShipReactorSymbol this package knows about.
+func AllShipReactorSymbols() []ShipReactorSymbol {
+	return api.AllShipReactorSymbols()
+}
+
+// The size of the deposit. This value indicates how much can be extracted from the survey before it is exhausted.
+type SurveySize = api.SurveySize
+
+const (
+	SURVEY_SIZE_SMALL    = api.SURVEY_SIZE_SMALL
+	SURVEY_SIZE_MODERATE = api.SURVEY_SIZE_MODERATE
+	SURVEY_SIZE_LARGE    = api.SURVEY_SIZE_LARGE
+)
+
+// Every SurveySize this package knows about.
+func AllSurveySizes() []SurveySize {
+	return api.AllSurveySizes()
+}
@@ -0,0 +1,96 @@
+package space_traders_api
+
+import (
+	"fmt"
+
+	"github.com/brendoncdodd/space_traders_api/api"
+)
+
+// Generated from the OpenAPI document.
+type (
+	Survey          = api.Survey
+	SurveyDeposit   = api.SurveyDeposit
+	Extraction      = api.Extraction
+	ExtractionYield = api.ExtractionYield
+)
+
+type ExtractResult struct {
+	Extraction *Extraction
+	Cooldown   *ShipCooldown
+	Cargo      *ShipCargo
+}
+
+// Surveys the waypoint the ship is orbiting. Needs a surveyor mount.
+func CreateSurvey(shipSymbol string, token string) ([]Survey, *ShipCooldown, error) {
+	respObject := new(struct {
+		Cooldown *ShipCooldown
+		Surveys  []Survey
+	})
+
+	err := shipAction("POST", shipSymbol, "survey", token, nil, respObject)
+
+	return respObject.Surveys, respObject.Cooldown, err
+}
+
+// Extracts from the asteroid the ship is orbiting. Needs a mining laser.
+// If survey isn't nil it's used to target its deposits.
+func ExtractResources(shipSymbol string, survey *Survey, token string) (*ExtractResult, error) {
+	result := new(ExtractResult)
+
+	action := "extract"
+	var body any
+	if survey != nil {
+		action = "extract/survey"
+		body = survey
+	}
+
+	err := shipAction("POST", shipSymbol, action, token, body, result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Extraction == nil {
+		return result, fmt.Errorf(
+			"Extracting with %s. %w",
+			shipSymbol,
+			NoContentError,
+		)
+	}
+
+	return result, nil
+}
+
+// Throws away units of a good.
+func JettisonCargo(
+	shipSymbol string,
+	tradeSymbol TradeSymbol,
+	units int,
+	token string,
+) (*ShipCargo, error) {
+	respObject := new(struct{ Cargo *ShipCargo })
+
+	err := shipAction(
+		"POST",
+		shipSymbol,
+		"jettison",
+		token,
+		map[string]any{"symbol": tradeSymbol, "units": units},
+		respObject,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return respObject.Cargo, nil
+}
+
+// Whether the ship has a surveyor mount.
+func (self *Ship) CanSurvey() bool {
+	for _, mount := range self.Mounts {
+		switch mount.Symbol {
+		case MOUNT_SURVEYOR_I, MOUNT_SURVEYOR_II, MOUNT_SURVEYOR_III:
+			return true
+		}
+	}
+
+	return false
+}
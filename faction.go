@@ -0,0 +1,331 @@
+package space_traders_api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/brendoncdodd/space_traders_api/api"
+)
+
+// Generated from the OpenAPI document.
+type (
+	Faction      = api.Faction
+	FactionTrait = api.FactionTrait
+)
+
+// An agent's standing with a faction.
+type FactionReputation struct {
+	Symbol     string
+	Reputation int
+}
+
+// Gets every faction.
+func GetFactions() (factions []Faction, err error) {
+	errPrefix := "Getting factions."
+	respObject := new(struct {
+		Data  []Faction
+		Meta  map[string]int
+		Error *STJsonError
+	})
+
+	req, err := newRequest("GET", "/factions", "", nil)
+	if err != nil {
+		return factions, fmt.Errorf("%s %w", errPrefix, err)
+	}
+	defer req.Body.Close()
+
+	for page := 1; respObject.Meta == nil || respObject.Meta["total"] > respObject.Meta["page"]*MAX_PAGE_LIMIT; page++ {
+		q := req.URL.Query()
+		q.Set("limit", strconv.Itoa(MAX_PAGE_LIMIT))
+		q.Set("page", strconv.Itoa(page))
+		req.URL.RawQuery = q.Encode()
+
+		buf, err := SendRequest(req)
+		if err != nil {
+			return factions, fmt.Errorf(
+				"%s Sending request for page %d.\n%w",
+				errPrefix,
+				page,
+				err,
+			)
+		}
+
+		respObject.Data = nil
+		err = json.Unmarshal(buf, respObject)
+		if err != nil {
+			return factions, fmt.Errorf(
+				"%s Unmarshalling JSON page %d.\n%w",
+				errPrefix,
+				page,
+				err,
+			)
+		}
+		if respObject.Error != nil {
+			return factions, fmt.Errorf(
+				"%s spacetraders.io error on page %d.\n%w",
+				errPrefix,
+				page,
+				respObject.Error,
+			)
+		}
+
+		factions = append(factions, respObject.Data...)
+	}
+
+	return factions, nil
+}
+
+// https://api.spacetraders.io/v2/factions/{factionSymbol}
+func GetFaction(factionSymbol string) (*Faction, error) {
+	errPrefix := "Getting faction " + factionSymbol + "."
+	respObject := new(struct {
+		Data  *Faction
+		Error *STJsonError
+	})
+
+	req, err := newRequest("GET", "/factions/"+factionSymbol, "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", errPrefix, err)
+	}
+	defer req.Body.Close()
+
+	buf, err := SendRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Sending request.\n%w",
+			errPrefix,
+			err,
+		)
+	}
+
+	err = json.Unmarshal(buf, respObject)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Unmarshaling response.\n%w",
+			errPrefix,
+			err,
+		)
+	}
+	if respObject.Error != nil {
+		return respObject.Data, fmt.Errorf(
+			"%s spacetraders.io error. %w",
+			errPrefix,
+			respObject.Error,
+		)
+	}
+	if respObject.Data == nil {
+		return nil, fmt.Errorf(
+			"%s %w",
+			errPrefix,
+			NoContentError,
+		)
+	}
+
+	return respObject.Data, nil
+}
+
+// Gets the agent's reputation with every faction.
+func GetMyFactions(token string) (reputations []FactionReputation, err error) {
+	errPrefix := "Getting my factions."
+	respObject := new(struct {
+		Data  []FactionReputation
+		Meta  map[string]int
+		Error *STJsonError
+	})
+
+	req, err := newRequest("GET", "/my/factions", token, nil)
+	if err != nil {
+		return reputations, fmt.Errorf("%s %w", errPrefix, err)
+	}
+	defer req.Body.Close()
+
+	for page := 1; respObject.Meta == nil || respObject.Meta["total"] > respObject.Meta["page"]*MAX_PAGE_LIMIT; page++ {
+		q := req.URL.Query()
+		q.Set("limit", strconv.Itoa(MAX_PAGE_LIMIT))
+		q.Set("page", strconv.Itoa(page))
+		req.URL.RawQuery = q.Encode()
+
+		buf, err := SendRequest(req)
+		if err != nil {
+			return reputations, fmt.Errorf(
+				"%s Sending request for page %d.\n%w",
+				errPrefix,
+				page,
+				err,
+			)
+		}
+
+		respObject.Data = nil
+		err = json.Unmarshal(buf, respObject)
+		if err != nil {
+			return reputations, fmt.Errorf(
+				"%s Unmarshalling JSON page %d.\n%w",
+				errPrefix,
+				page,
+				err,
+			)
+		}
+		if respObject.Error != nil {
+			return reputations, fmt.Errorf(
+				"%s spacetraders.io error on page %d.\n%w",
+				errPrefix,
+				page,
+				respObject.Error,
+			)
+		}
+
+		reputations = append(reputations, respObject.Data...)
+	}
+
+	return reputations, nil
+}
+
+type ReputationChange struct {
+	Faction string
+	Time    time.Time
+	Before  int
+	After   int
+	// The contract whose fulfillment prompted the check, if any.
+	ContractID string
+}
+
+// Keeps the last known reputation with each faction and every change to it.
+// If Filename isn't empty the tracker is saved there after every change.
+type ReputationTracker struct {
+	Current  map[string]int
+	History  []ReputationChange
+	Filename string `json:"-"`
+}
+
+// Loads a tracker from filename, or starts an empty one if the file doesn't exist.
+func LoadReputationTracker(filename string) (*ReputationTracker, error) {
+	errPrefix := "Loading reputation tracker."
+	tracker := &ReputationTracker{
+		Current:  make(map[string]int),
+		Filename: filename,
+	}
+
+	fileData, err := os.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return tracker, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Reading %s %w",
+			errPrefix,
+			filename,
+			err,
+		)
+	}
+
+	err = json.Unmarshal(fileData, tracker)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Decoding JSON from %s %w",
+			errPrefix,
+			filename,
+			err,
+		)
+	}
+	if tracker.Current == nil {
+		tracker.Current = make(map[string]int)
+	}
+
+	return tracker, nil
+}
+
+func (self *ReputationTracker) Save() error {
+	if self.Filename == "" {
+		return nil
+	}
+
+	fileData, err := json.Marshal(self)
+	if err != nil {
+		return fmt.Errorf(
+			"Saving reputation tracker. Encoding JSON. %w",
+			err,
+		)
+	}
+
+	err = os.WriteFile(self.Filename, fileData, fs.ModePerm)
+	if err != nil {
+		return fmt.Errorf(
+			"Saving reputation tracker. Writing %s %w",
+			self.Filename,
+			err,
+		)
+	}
+
+	return nil
+}
+
+// Compares reputations with the last known ones and records what changed.
+// A faction seen for the first time is recorded as a change from 0.
+func (self *ReputationTracker) Apply(
+	reputations []FactionReputation,
+	contractID string,
+	at time.Time,
+) (changes []ReputationChange) {
+	if self.Current == nil {
+		self.Current = make(map[string]int)
+	}
+
+	for _, reputation := range reputations {
+		before, known := self.Current[reputation.Symbol]
+		if known && before == reputation.Reputation {
+			continue
+		}
+
+		changes = append(changes, ReputationChange{
+			Faction:    reputation.Symbol,
+			Time:       at,
+			Before:     before,
+			After:      reputation.Reputation,
+			ContractID: contractID,
+		})
+		self.Current[reputation.Symbol] = reputation.Reputation
+	}
+
+	self.History = append(self.History, changes...)
+
+	return changes
+}
+
+// Gets the agent's reputations and records any changes.
+// Pass the ID of a contract that was just fulfilled so the change can be traced back to it,
+// or "" for a routine check.
+func (self *ReputationTracker) Record(token string, contractID string) ([]ReputationChange, error) {
+	errPrefix := "Recording reputation."
+
+	reputations, err := GetMyFactions(token)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", errPrefix, err)
+	}
+
+	changes := self.Apply(reputations, contractID, time.Now())
+	if len(changes) == 0 {
+		return changes, nil
+	}
+
+	err = self.Save()
+	if err != nil {
+		return changes, fmt.Errorf("%s %w", errPrefix, err)
+	}
+
+	return changes, nil
+}
+
+// Changes with one faction, oldest first.
+func (self *ReputationTracker) FactionHistory(factionSymbol string) (history []ReputationChange) {
+	for _, change := range self.History {
+		if change.Faction == factionSymbol {
+			history = append(history, change)
+		}
+	}
+
+	return history
+}
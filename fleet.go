@@ -1,105 +1,57 @@
 package space_traders_api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
+
+	"github.com/brendoncdodd/space_traders_api/api"
 )
 
+// The API's ship, embedded so the helpers here can be methods on it.
 type Ship struct {
-	Symbol       string
-	Registration *struct {
-		Name          string
-		FactionSymbol string
-		Role          string
-	}
-	Nav  *ShipNav
-	Crew *struct {
-		Current  int
-		Required int
-		Capacity int
-		Rotation string
-		Morale   int
-		Wages    int
-	}
-	Frame *struct {
-		Symbol         string
-		Name           string
-		Description    string
-		Condition      int
-		Integrity      int
-		ModuleSlots    int
-		MountingPoints int
-		FuelCapacity   int
-		Requirements   *struct {
-			Power int
-			Crew  int
-			Slots int
-		}
-	}
-	Reactor *struct {
-		Symbol       string
-		Name         string
-		Description  string
-		Condition    int
-		Integrity    int
-		PowerOutput  int
-		Requirements *struct {
-			Power int
-			Crew  int
-			Slots int
-		}
-	}
-	Engine *struct {
-		Symbol       string
-		Name         string
-		Description  string
-		Condition    int
-		Integrity    int
-		Speed        int
-		Requirements *struct {
-			Power int
-			Crew  int
-			Slots int
-		}
+	api.Ship
+}
+
+// Generated from the OpenAPI document.
+type (
+	ShipRegistration     = api.ShipRegistration
+	ShipFrame            = api.ShipFrame
+	ShipReactor          = api.ShipReactor
+	ShipEngine           = api.ShipEngine
+	ShipCrew             = api.ShipCrew
+	ShipFuel             = api.ShipFuel
+	ShipCooldown         = api.Cooldown
+	ShipCargo            = api.ShipCargo
+	ShipCargoItem        = api.ShipCargoItem
+	ShipRequirements     = api.ShipRequirements
+	ShipModule           = api.ShipModule
+	ShipMount            = api.ShipMount
+	ShipNav              = api.ShipNav
+	ShipNavRoute         = api.ShipNavRoute
+	ShipNavRouteWaypoint = api.ShipNavRouteWaypoint
+)
+
+// Blocks until the cooldown has ended or ctx is done.
+func WaitForCooldown(ctx context.Context, cooldown *ShipCooldown) error {
+	wait := time.Until(cooldown.ExpiresAt())
+	if wait <= 0 {
+		return nil
 	}
-	Cooldown *struct {
-		ShipSymbol       string
-		TotalSeconds     int
-		RemainingSeconds int
-		Expiration       string
-	}
-	Modules []*struct {
-		Symbol       string
-		Capacity     int
-		Range        int
-		Name         string
-		Description  string
-		Requirements any //Schema says {}
-	}
-	Mounts []*struct {
-		Symbol       string
-		Name         string
-		Description  string
-		Strength     int
-		Deposits     []any //Schema says [ null ]
-		Requirements any   //Schema says {}
-	}
-	Cargo *struct {
-		Capacity  int
-		Units     int
-		Inventory []any //Schema says [ {} ]
-	}
-	Fuel *struct {
-		Current  int
-		Capacity int
-		Consumed *struct {
-			Amount    int
-			Timestamp string
-		}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
 	}
 }
 
@@ -128,31 +80,6 @@ func (self *Ship) String() string {
 	)
 }
 
-type ShipNav struct {
-	SystemSymbol   string
-	WaypointSymbol string
-	Route          struct {
-		Destination struct {
-			Symbol       string
-			Type         string
-			SystemSymbol string
-			X            int
-			Y            int
-		}
-		Origin struct {
-			Symbol       string
-			Type         string
-			SystemSymbol string
-			X            int
-			Y            int
-		}
-		DepartureTime string
-		Arrival       string
-	}
-	Status     string
-	FlightMode string
-}
-
 // Gets all of an agent's ships
 func GetShipsByAgent(token string) (ships []Ship, err error) {
 	errPrefix := "Getting agent's ships."
@@ -368,3 +295,202 @@ func GetShipLocation(shipSymbol string, token string) (Vector2, error) {
 	}
 	return shipLocation, nil
 }
+
+// Sends method to path and decodes data into result.
+// body is sent as JSON unless it's nil.
+func apiAction(
+	method string,
+	path string,
+	token string,
+	body any,
+	result any,
+) error {
+	errPrefix := fmt.Sprintf("Trying to %s %s.", method, path)
+	respObject := new(struct {
+		Data  json.RawMessage
+		Error *STJsonError
+	})
+
+	req, err := newRequest(method, path, token, body)
+	if err != nil {
+		return fmt.Errorf("%s %w", errPrefix, err)
+	}
+	defer req.Body.Close()
+
+	buf, err := SendRequest(req)
+	if err != nil {
+		return fmt.Errorf(
+			"%s Sending request.\n%w",
+			errPrefix,
+			err,
+		)
+	}
+
+	err = json.Unmarshal(buf, respObject)
+	if err != nil {
+		return fmt.Errorf(
+			"%s Unmarshaling response.\n%w",
+			errPrefix,
+			err,
+		)
+	}
+	if respObject.Error != nil {
+		return fmt.Errorf(
+			"%s spacetraders.io error. %w",
+			errPrefix,
+			respObject.Error,
+		)
+	}
+	if len(respObject.Data) == 0 || string(respObject.Data) == "null" {
+		return fmt.Errorf("%s %w", errPrefix, NoContentError)
+	}
+
+	err = json.Unmarshal(respObject.Data, result)
+	if err != nil {
+		return fmt.Errorf(
+			"%s Unmarshaling data.\n%w",
+			errPrefix,
+			err,
+		)
+	}
+
+	return nil
+}
+
+// Sends method to /my/ships/{shipSymbol}/{action} and decodes data into result.
+func shipAction(
+	method string,
+	shipSymbol string,
+	action string,
+	token string,
+	body any,
+	result any,
+) error {
+	return apiAction(method, "/my/ships/"+shipSymbol+"/"+action, token, body, result)
+}
+
+// Moves the ship into orbit. Needed before navigating.
+func OrbitShip(shipSymbol string, token string) (*ShipNav, error) {
+	respObject := new(struct{ Nav *ShipNav })
+
+	err := shipAction("POST", shipSymbol, "orbit", token, nil, respObject)
+	if err != nil {
+		return nil, err
+	}
+	if respObject.Nav == nil {
+		return nil, fmt.Errorf("Orbiting %s. %w", shipSymbol, NoContentError)
+	}
+
+	return respObject.Nav, nil
+}
+
+// Docks the ship. Needed before trading, refueling or repairing.
+func DockShip(shipSymbol string, token string) (*ShipNav, error) {
+	respObject := new(struct{ Nav *ShipNav })
+
+	err := shipAction("POST", shipSymbol, "dock", token, nil, respObject)
+	if err != nil {
+		return nil, err
+	}
+	if respObject.Nav == nil {
+		return nil, fmt.Errorf("Docking %s. %w", shipSymbol, NoContentError)
+	}
+
+	return respObject.Nav, nil
+}
+
+// Sends the ship to a waypoint in its system. The ship must be in orbit.
+// Use WaitForArrival with the returned nav to know when it gets there.
+func NavigateShip(
+	shipSymbol string,
+	waypointSymbol string,
+	token string,
+) (*ShipNav, *ShipFuel, error) {
+	respObject := new(struct {
+		Nav  *ShipNav
+		Fuel *ShipFuel
+	})
+
+	err := shipAction(
+		"POST",
+		shipSymbol,
+		"navigate",
+		token,
+		map[string]string{"waypointSymbol": waypointSymbol},
+		respObject,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	if respObject.Nav == nil {
+		return nil, respObject.Fuel, fmt.Errorf(
+			"Navigating %s to %s. %w",
+			shipSymbol,
+			waypointSymbol,
+			NoContentError,
+		)
+	}
+
+	return respObject.Nav, respObject.Fuel, nil
+}
+
+// Blocks until the ship has arrived or ctx is done.
+func WaitForArrival(ctx context.Context, nav *ShipNav) error {
+	if nav == nil || nav.Status != NAV_STATUS_IN_TRANSIT {
+		return nil
+	}
+
+	wait := time.Until(nav.ArrivesAt())
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type ChartResult struct {
+	Chart       *WaypointChart
+	Waypoint    *Waypoint
+	Agent       *Agent
+	Transaction *struct {
+		WaypointSymbol string
+		ShipSymbol     string
+		TotalPrice     int
+		Timestamp      string
+	}
+}
+
+// Submits a chart of the waypoint the ship is at.
+// The charted waypoint replaces the cached one.
+func CreateChart(shipSymbol string, token string) (*ChartResult, error) {
+	result := new(ChartResult)
+
+	err := shipAction("POST", shipSymbol, "chart", token, nil, result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Waypoint == nil {
+		return result, fmt.Errorf("Charting with %s. %w", shipSymbol, NoContentError)
+	}
+
+	cacheWaypoints([]Waypoint{*result.Waypoint})
+
+	return result, nil
+}
+
+// Credits paid for the chart, 0 if the server didn't say.
+func (self *ChartResult) Earned() int {
+	if self.Transaction == nil {
+		return 0
+	}
+
+	return self.Transaction.TotalPrice
+}
@@ -0,0 +1,374 @@
+package space_traders_api
+
+import (
+	"container/heap"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+)
+
+const (
+	PATH_STEP_JUMP = "JUMP"
+	PATH_STEP_WARP = "WARP"
+)
+
+var NoPathError = fmt.Errorf("No path between systems.")
+
+// A system in the galaxy graph.
+// Connections holds the symbols of the jump gate waypoints this system's gate links to.
+// GateUnknown is set when the gate couldn't be looked up, so it isn't jumped through.
+type GalaxyNode struct {
+	Symbol                string
+	X                     int
+	Y                     int
+	GateSymbol            string
+	GateUnderConstruction bool
+	GateUnknown           bool
+	Connections           []string
+}
+
+// Systems as nodes, jump gate connections as edges.
+// Warp edges aren't stored, they're worked out from coordinates when searching.
+type Galaxy struct {
+	Nodes map[string]*GalaxyNode
+}
+
+type GalaxyPathStep struct {
+	From     string
+	To       string
+	Type     string
+	Distance float64
+}
+
+// Makes a galaxy graph with no gate connections from a list of systems.
+func NewGalaxy(systems []System) *Galaxy {
+	galaxy := &Galaxy{Nodes: make(map[string]*GalaxyNode)}
+
+	for _, system := range systems {
+		node := &GalaxyNode{
+			Symbol: system.Symbol,
+			X:      system.X,
+			Y:      system.Y,
+		}
+
+		for _, waypoint := range system.Waypoints {
+			if waypoint.Type == WAYPOINT_TYPE_JUMP_GATE {
+				node.GateSymbol = waypoint.Symbol
+			}
+		}
+
+		galaxy.Nodes[system.Symbol] = node
+	}
+
+	return galaxy
+}
+
+// Records a system's jump gate and its connections.
+func (self *Galaxy) SetJumpGate(
+	systemSymbol string,
+	gate JumpGate,
+	underConstruction bool,
+) error {
+	node, ok := self.Nodes[systemSymbol]
+	if !ok {
+		return fmt.Errorf(
+			"Setting jump gate %s. Unknown system %s.",
+			gate.Symbol,
+			systemSymbol,
+		)
+	}
+
+	node.GateSymbol = gate.Symbol
+	node.GateUnderConstruction = underConstruction
+	node.GateUnknown = false
+	node.Connections = gate.Connections
+
+	return nil
+}
+
+// Builds the whole galaxy graph from the API.
+// Gets every system, then the waypoint and connections of every jump gate.
+// A system whose gate can't be got is marked GateUnknown and left without connections,
+// and the error is put in failed under the system's symbol, so one bad gate doesn't stop the build.
+// This makes a lot of requests. Save the result with Galaxy.Save().
+func BuildGalaxy() (galaxy *Galaxy, failed map[string]error, err error) {
+	errPrefix := "Building galaxy."
+	failed = make(map[string]error)
+
+	systems, err := GetSystems()
+	if err != nil {
+		return nil, failed, fmt.Errorf(
+			"%s Getting systems.\n%w",
+			errPrefix,
+			err,
+		)
+	}
+
+	galaxy = NewGalaxy(systems)
+
+	for systemSymbol, node := range galaxy.Nodes {
+		if node.GateSymbol == "" {
+			continue
+		}
+
+		waypoint, err := GetWaypoint(node.GateSymbol)
+		if err != nil {
+			node.GateUnknown = true
+			failed[systemSymbol] = fmt.Errorf(
+				"%s Getting jump gate waypoint %s.\n%w",
+				errPrefix,
+				node.GateSymbol,
+				err,
+			)
+			continue
+		}
+
+		gate, err := GetJumpGate(node.GateSymbol)
+		if err != nil {
+			node.GateUnknown = true
+			failed[systemSymbol] = fmt.Errorf(
+				"%s Getting jump gate %s.\n%w",
+				errPrefix,
+				node.GateSymbol,
+				err,
+			)
+			continue
+		}
+
+		err = galaxy.SetJumpGate(systemSymbol, *gate, waypoint.IsUnderConstruction)
+		if err != nil {
+			failed[systemSymbol] = fmt.Errorf("%s %w", errPrefix, err)
+		}
+	}
+
+	return galaxy, failed, nil
+}
+
+func LoadGalaxy(filename string) (*Galaxy, error) {
+	errPrefix := "Loading galaxy."
+	galaxy := new(Galaxy)
+
+	fileData, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Reading galaxy file %s %w",
+			errPrefix,
+			filename,
+			err,
+		)
+	}
+
+	err = json.Unmarshal(fileData, galaxy)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Decoding JSON from %s %w",
+			errPrefix,
+			filename,
+			err,
+		)
+	}
+
+	if galaxy.Nodes == nil {
+		galaxy.Nodes = make(map[string]*GalaxyNode)
+	}
+
+	return galaxy, nil
+}
+
+func (self *Galaxy) Save(filename string) error {
+	errPrefix := "Saving galaxy."
+
+	fileData, err := json.Marshal(self)
+	if err != nil {
+		return fmt.Errorf(
+			"%s Encoding JSON. %w",
+			errPrefix,
+			err,
+		)
+	}
+
+	err = os.WriteFile(filename, fileData, fs.ModePerm)
+	if err != nil {
+		return fmt.Errorf(
+			"%s Writing galaxy file %s %w",
+			errPrefix,
+			filename,
+			err,
+		)
+	}
+
+	return nil
+}
+
+// Whether a gate in this system can be jumped from or to.
+func (self *GalaxyNode) canJump() bool {
+	return self.GateSymbol != "" && !self.GateUnderConstruction && !self.GateUnknown
+}
+
+type galaxyQueueItem struct {
+	symbol string
+	cost   float64
+}
+
+type galaxyQueue []galaxyQueueItem
+
+func (self galaxyQueue) Len() int           { return len(self) }
+func (self galaxyQueue) Less(i, j int) bool { return self[i].cost < self[j].cost }
+func (self galaxyQueue) Swap(i, j int)      { self[i], self[j] = self[j], self[i] }
+func (self *galaxyQueue) Push(x any)        { *self = append(*self, x.(galaxyQueueItem)) }
+func (self *galaxyQueue) Pop() any {
+	old := *self
+	item := old[len(old)-1]
+	*self = old[:len(old)-1]
+	return item
+}
+
+// Finds the cheapest sequence of jumps and warps between two systems.
+// A jump costs 1, a warp costs its distance, so gates are preferred wherever they exist.
+// Gates under construction or marked GateUnknown can't be jumped from or to.
+// Warps longer than maxWarp aren't considered. Pass 0 to only use gates.
+func (self *Galaxy) FindPath(
+	fromSystem string,
+	toSystem string,
+	maxWarp float64,
+) ([]GalaxyPathStep, error) {
+	errPrefix := fmt.Sprintf(
+		"Finding path from %s to %s.",
+		fromSystem,
+		toSystem,
+	)
+
+	if _, ok := self.Nodes[fromSystem]; !ok {
+		return nil, fmt.Errorf("%s Unknown system %s.", errPrefix, fromSystem)
+	}
+	if _, ok := self.Nodes[toSystem]; !ok {
+		return nil, fmt.Errorf("%s Unknown system %s.", errPrefix, toSystem)
+	}
+
+	costs := map[string]float64{fromSystem: 0}
+	previous := make(map[string]GalaxyPathStep)
+	visited := make(map[string]bool)
+	queue := &galaxyQueue{{symbol: fromSystem, cost: 0}}
+
+	relax := func(step GalaxyPathStep, cost float64) {
+		if known, ok := costs[step.To]; ok && known <= cost {
+			return
+		}
+		costs[step.To] = cost
+		previous[step.To] = step
+		heap.Push(queue, galaxyQueueItem{symbol: step.To, cost: cost})
+	}
+
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(galaxyQueueItem)
+		if visited[item.symbol] {
+			continue
+		}
+		visited[item.symbol] = true
+
+		if item.symbol == toSystem {
+			break
+		}
+
+		node := self.Nodes[item.symbol]
+		location := Vector2{node.X, node.Y}
+
+		if node.canJump() {
+			for _, connection := range node.Connections {
+				symbol, err := ParseWaypointSymbol(connection)
+				if err != nil {
+					continue
+				}
+
+				next, ok := self.Nodes[symbol.SystemSymbol()]
+				if !ok || visited[next.Symbol] || next.GateUnderConstruction || next.GateUnknown {
+					continue
+				}
+
+				relax(GalaxyPathStep{
+					From:     node.Symbol,
+					To:       next.Symbol,
+					Type:     PATH_STEP_JUMP,
+					Distance: location.Distance(Vector2{next.X, next.Y}),
+				}, item.cost+1)
+			}
+		}
+
+		if maxWarp <= 0 {
+			continue
+		}
+
+		for _, next := range self.Nodes {
+			if visited[next.Symbol] {
+				continue
+			}
+
+			d := location.Distance(Vector2{next.X, next.Y})
+			if d > maxWarp {
+				continue
+			}
+
+			relax(GalaxyPathStep{
+				From:     node.Symbol,
+				To:       next.Symbol,
+				Type:     PATH_STEP_WARP,
+				Distance: d,
+			}, item.cost+d)
+		}
+	}
+
+	if _, ok := costs[toSystem]; !ok {
+		return nil, fmt.Errorf("%s %w", errPrefix, NoPathError)
+	}
+
+	path := []GalaxyPathStep{}
+	for symbol := toSystem; symbol != fromSystem; {
+		step := previous[symbol]
+		path = append(path, step)
+		symbol = step.From
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path, nil
+}
+
+// Sum of the distances of every step in a path.
+func PathDistance(path []GalaxyPathStep) float64 {
+	total := 0.0
+	for _, step := range path {
+		total += step.Distance
+	}
+
+	return total
+}
+
+// A distance function for TradeRouteOptions that works across systems.
+// Within a system it's the distance between the waypoints,
+// otherwise it's the distance along FindPath between their systems.
+func (self *Galaxy) WaypointDistance(maxWarp float64) func(from string, to string) (float64, error) {
+	return func(from string, to string) (float64, error) {
+		fromSymbol, err := ParseWaypointSymbol(from)
+		if err != nil {
+			return 0, err
+		}
+		toSymbol, err := ParseWaypointSymbol(to)
+		if err != nil {
+			return 0, err
+		}
+
+		if fromSymbol.SystemSymbol() == toSymbol.SystemSymbol() {
+			return waypointDistance(from, to)
+		}
+
+		path, err := self.FindPath(fromSymbol.SystemSymbol(), toSymbol.SystemSymbol(), maxWarp)
+		if err != nil {
+			return 0, err
+		}
+
+		return PathDistance(path), nil
+	}
+}
@@ -1,16 +1,17 @@
 package space_traders_api
 
 import (
+	"context"
 	"fmt"
 	"math"
 )
 
-// Not implemented yet
-// TODO: Get symbols and locations of waypoint with traits.
-// TODO: Remove lines that assign test values.
+// Finds the waypoint closest to a ship, in the ship's system, that has all of traits.
+// Uses every waypoint in the system, so the cache only holds one copy per system
+// no matter which traits are asked for.
 func FindNearestWaypointWithTraits(
 	shipSymbol string,
-	traits []string,
+	traits []WaypointTraitSymbol,
 	token string,
 ) (
 	waypointSymbol string,
@@ -20,7 +21,16 @@ func FindNearestWaypointWithTraits(
 	minDistance := math.Inf(1)
 	waypointLocations := make(map[string]Vector2)
 
-	shipLocation, err := GetShipLocation(shipSymbol, token)
+	nav, err := GetShipNav(shipSymbol, token)
+	if err != nil {
+		return "", fmt.Errorf(
+			"%s Getting ship nav.%w",
+			errPrefix,
+			err,
+		)
+	}
+
+	shipLocation, err := GetWaypointLocation(nav.WaypointSymbol)
 	if err != nil {
 		return "", fmt.Errorf(
 			"%s Getting ship location.%w",
@@ -29,7 +39,7 @@ func FindNearestWaypointWithTraits(
 		)
 	}
 
-	waypoints, err := GetSystemWaypoints("", traits, "")
+	waypoints, err := GetAllWaypointsInSystem(nav.SystemSymbol)
 	if err != nil {
 		return waypointSymbol, fmt.Errorf(
 			"%s Getting waypoints.%w",
@@ -39,6 +49,10 @@ func FindNearestWaypointWithTraits(
 	}
 
 	for _, waypoint := range waypoints {
+		if !waypointHasTraits(waypoint, traits) {
+			continue
+		}
+
 		waypointLocations[waypoint.Symbol] = Vector2{
 			waypoint.X,
 			waypoint.Y,
@@ -53,5 +67,237 @@ func FindNearestWaypointWithTraits(
 		}
 	}
 
+	if waypointSymbol == "" {
+		return "", fmt.Errorf(
+			"%s %w No waypoint in %s with traits %v.",
+			errPrefix,
+			NoContentError,
+			nav.SystemSymbol,
+			traits,
+		)
+	}
+
 	return
 }
+
+func waypointHasTraits(waypoint Waypoint, traits []WaypointTraitSymbol) bool {
+	for _, trait := range traits {
+		if !waypoint.HasTrait(trait) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Orders locations by always going to the nearest one not yet visited.
+// Not the shortest tour, but close enough for a handful of waypoints.
+func nearestNeighbourTour(start Vector2, locations map[string]Vector2) []string {
+	tour := []string{}
+	remaining := make(map[string]Vector2, len(locations))
+	for symbol, location := range locations {
+		remaining[symbol] = location
+	}
+
+	current := start
+	for len(remaining) > 0 {
+		next := ""
+		minDistance := math.Inf(1)
+
+		for symbol, location := range remaining {
+			d := current.Distance(location)
+			if d < minDistance || (d == minDistance && symbol < next) {
+				next = symbol
+				minDistance = d
+			}
+		}
+
+		tour = append(tour, next)
+		current = remaining[next]
+		delete(remaining, next)
+	}
+
+	return tour
+}
+
+type ExplorationReport struct {
+	Charted       []string
+	Failed        map[string]error
+	CreditsEarned int
+	// Credits spent refueling on the way.
+	FuelSpent int
+}
+
+// Flies a ship to every UNCHARTED waypoint in its system and charts it,
+// going to the nearest one each time.
+// Before each leg the ship makes sure it has the fuel to get there and on to a market
+// that sells fuel, refueling where it is or going to the nearest such market first.
+// A waypoint that can't be charted is put in Failed and the ship moves on.
+// Stops early if ctx is done or the ship can't move.
+func ExploreSystem(
+	ctx context.Context,
+	shipSymbol string,
+	token string,
+) (*ExplorationReport, error) {
+	errPrefix := "Exploring with " + shipSymbol + "."
+	report := &ExplorationReport{Failed: make(map[string]error)}
+	uncharted := make(map[string]Vector2)
+	locations := make(map[string]Vector2)
+	fuelStops := make(map[string]Vector2)
+
+	ship, err := GetShip(shipSymbol, token)
+	if err != nil {
+		return report, fmt.Errorf("%s %w", errPrefix, err)
+	}
+
+	err = WaitForArrival(ctx, ship.Nav)
+	if err != nil {
+		return report, fmt.Errorf("%s %w", errPrefix, err)
+	}
+
+	waypoints, err := GetAllWaypointsInSystem(ship.Nav.SystemSymbol)
+	if err != nil {
+		return report, fmt.Errorf("%s %w", errPrefix, err)
+	}
+
+	for _, waypoint := range waypoints {
+		locations[waypoint.Symbol] = Vector2{waypoint.X, waypoint.Y}
+		if waypoint.HasTrait(WAYPOINT_TRAIT_UNCHARTED) {
+			uncharted[waypoint.Symbol] = Vector2{waypoint.X, waypoint.Y}
+		}
+	}
+
+	usesFuel := ship.Fuel != nil && ship.Fuel.Capacity > 0
+	if usesFuel {
+		markets, err := GetSystemMarkets(ship.Nav.SystemSymbol, token)
+		if err != nil {
+			return report, fmt.Errorf("%s Finding fuel.\n%w", errPrefix, err)
+		}
+		for i := range markets {
+			if markets[i].Trades(TRADE_SYMBOL_FUEL) {
+				fuelStops[markets[i].Symbol] = locations[markets[i].Symbol]
+			}
+		}
+	}
+
+	distance := func(from string, to string) float64 {
+		location := locations[from]
+		return location.Distance(locations[to])
+	}
+
+	nearestFuel := func(from string) (string, float64) {
+		nearest := ""
+		nearestDistance := math.Inf(1)
+		for stop := range fuelStops {
+			if d := distance(from, stop); d < nearestDistance || (d == nearestDistance && stop < nearest) {
+				nearest = stop
+				nearestDistance = d
+			}
+		}
+		return nearest, nearestDistance
+	}
+
+	// Enough to fly from one waypoint to another and still reach fuel after.
+	fuelNeeded := func(from string, to string) int {
+		needed := FuelCost(distance(from, to), ship.Nav.FlightMode)
+		if stop, d := nearestFuel(to); stop != "" && stop != to {
+			needed += FuelCost(d, ship.Nav.FlightMode)
+		}
+		return needed
+	}
+
+	fly := func(destination string) error {
+		err := ensureOrbit(ship, token)
+		if err != nil {
+			return err
+		}
+
+		nav, fuel, err := NavigateShip(shipSymbol, destination, token)
+		if err != nil {
+			return err
+		}
+		ship.Nav = nav
+		if fuel != nil {
+			ship.Fuel = fuel
+		}
+
+		err = WaitForArrival(ctx, nav)
+		if err != nil {
+			return err
+		}
+		ship.Nav.Status = NAV_STATUS_IN_ORBIT
+
+		return nil
+	}
+
+	refuelHere := func() error {
+		if _, ok := fuelStops[ship.Nav.WaypointSymbol]; !ok || ship.Fuel.Current >= ship.Fuel.Capacity {
+			return nil
+		}
+
+		err := ensureDocked(ship, token)
+		if err != nil {
+			return err
+		}
+
+		result, err := RefuelShip(shipSymbol, 0, false, token)
+		if err != nil {
+			return err
+		}
+		ship.Fuel = result.Fuel
+		if result.Transaction != nil {
+			report.FuelSpent += result.Transaction.TotalPrice
+		}
+
+		return nil
+	}
+
+	start := Vector2{ship.Nav.Route.Destination.X, ship.Nav.Route.Destination.Y}
+
+	for _, waypointSymbol := range nearestNeighbourTour(start, uncharted) {
+		if ctx.Err() != nil {
+			return report, fmt.Errorf("%s %w", errPrefix, ctx.Err())
+		}
+
+		if ship.Nav.WaypointSymbol != waypointSymbol {
+			here := ship.Nav.WaypointSymbol
+			if usesFuel && len(fuelStops) > 0 && ship.Fuel.Current < fuelNeeded(here, waypointSymbol) {
+				err = refuelHere()
+				if err != nil {
+					return report, fmt.Errorf("%s Refueling.\n%w", errPrefix, err)
+				}
+
+				if stop, _ := nearestFuel(here); ship.Fuel.Current < fuelNeeded(here, waypointSymbol) && stop != here {
+					err = fly(stop)
+					if err == nil {
+						err = refuelHere()
+					}
+					if err != nil {
+						return report, fmt.Errorf("%s Going to refuel at %s.\n%w", errPrefix, stop, err)
+					}
+				}
+			}
+
+			err = fly(waypointSymbol)
+			if err != nil {
+				return report, fmt.Errorf("%s %w", errPrefix, err)
+			}
+		}
+
+		err = ensureOrbit(ship, token)
+		if err != nil {
+			return report, fmt.Errorf("%s %w", errPrefix, err)
+		}
+
+		chart, err := CreateChart(shipSymbol, token)
+		if err != nil {
+			report.Failed[waypointSymbol] = err
+			continue
+		}
+
+		report.Charted = append(report.Charted, waypointSymbol)
+		report.CreditsEarned += chart.Earned()
+	}
+
+	return report, nil
+}
@@ -0,0 +1,171 @@
+// Command enumgen re-exports the enums openapigen writes into another package,
+// so they can be used without importing the generated one.
+//
+//	go run ../internal/enumgen -dir . -out ../enums_gen.go -package space_traders_api
+//
+// It reads the enums from the generated package rather than the OpenAPI document,
+// so the names and constants are whatever openapigen decided on.
+// An enum is a string type with an All<Name>s function, in the order the package declares them.
+// Its doc comment comes along.
+// Each enum gets an alias of the generated type, a constant per value
+// and All<Name>s. IsKnown and Validate come with the type.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+type enum struct {
+	Name   string
+	Doc    string
+	Consts []string
+}
+
+func main() {
+	dir := flag.String("dir", "api", "directory of the package with the enums")
+	out := flag.String("out", "enums_gen.go", "Go file to write")
+	pkg := flag.String("package", "space_traders_api", "package of the generated file")
+	from := flag.String("from", "github.com/brendoncdodd/space_traders_api/api", "import path of the package in dir")
+	flag.Parse()
+
+	enums, err := readEnums(*dir)
+	if err != nil {
+		log.Fatalf("enumgen: Reading enums from %s %s", *dir, err.Error())
+	}
+
+	src, err := generate(*pkg, *from, enums)
+	if err != nil {
+		log.Fatalf("enumgen: %s", err.Error())
+	}
+
+	err = os.WriteFile(*out, src, 0644)
+	if err != nil {
+		log.Fatalf("enumgen: Writing %s %s", *out, err.Error())
+	}
+}
+
+func plural(name string) string {
+	if strings.HasSuffix(name, "s") {
+		return name + "es"
+	}
+
+	return name + "s"
+}
+
+// The enums declared in the Go files in dir, in the order they're declared.
+func readEnums(dir string) ([]enum, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	fset := token.NewFileSet()
+	types := []enum{}
+	consts := make(map[string][]string)
+	funcs := make(map[string]bool)
+
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+
+		parsed, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("Parsing %s. %w", file, err)
+		}
+
+		for _, decl := range parsed.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil {
+					funcs[decl.Name.Name] = true
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						if ident, ok := spec.Type.(*ast.Ident); !ok || ident.Name != "string" || spec.Assign != 0 {
+							continue
+						}
+
+						doc := decl.Doc
+						if spec.Doc != nil {
+							doc = spec.Doc
+						}
+						types = append(types, enum{Name: spec.Name.Name, Doc: doc.Text()})
+					case *ast.ValueSpec:
+						if decl.Tok != token.CONST || spec.Type == nil {
+							continue
+						}
+						ident, ok := spec.Type.(*ast.Ident)
+						if !ok {
+							continue
+						}
+						for _, name := range spec.Names {
+							consts[ident.Name] = append(consts[ident.Name], name.Name)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	enums := []enum{}
+	for _, e := range types {
+		if !funcs["All"+plural(e.Name)] {
+			continue
+		}
+		e.Consts = consts[e.Name]
+		enums = append(enums, e)
+	}
+
+	return enums, nil
+}
+
+func generate(pkg string, from string, enums []enum) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	name := from[strings.LastIndex(from, "/")+1:]
+
+	fmt.Fprintf(buf, "// Code generated by enumgen from %s. DO NOT EDIT.\n\n", from)
+	fmt.Fprintf(buf, "package %s\n\n", pkg)
+	fmt.Fprintf(buf, "import %q\n\n", from)
+
+	for _, e := range enums {
+		if e.Doc != "" {
+			for _, line := range strings.Split(strings.TrimSpace(e.Doc), "\n") {
+				fmt.Fprintf(buf, "// %s\n", line)
+			}
+		}
+		fmt.Fprintf(buf, "type %s = %s.%s\n\n", e.Name, name, e.Name)
+
+		fmt.Fprintf(buf, "const (\n")
+		for _, constName := range e.Consts {
+			fmt.Fprintf(buf, "\t%s = %s.%s\n", constName, name, constName)
+		}
+		fmt.Fprintf(buf, ")\n\n")
+
+		fmt.Fprintf(buf, "// Every %s this package knows about.\n", e.Name)
+		fmt.Fprintf(buf, "func All%s() []%s {\n", plural(e.Name), e.Name)
+		fmt.Fprintf(buf, "\treturn %s.All%s()\n", name, plural(e.Name))
+		fmt.Fprintf(buf, "}\n\n")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return buf.Bytes(), fmt.Errorf("Formatting generated code. %w", err)
+	}
+
+	return src, nil
+}
@@ -0,0 +1,762 @@
+// Command openapigen writes Go model types and endpoint functions from an
+// OpenAPI 3 document.
+//
+//	go run ../internal/openapigen -in ../spec/SpaceTraders.json -out api_gen.go -package api
+//
+// Every schema in components.schemas becomes a type:
+// objects become structs, string enums become string types with a constant per value
+// and the same IsKnown, Validate and All<Name>s helpers enumgen writes,
+// anything else becomes an alias of its Go type.
+// Enum constants are named prefix+value. There's no prefix when the values already start
+// with the same word, like FRAME_PROBE and FRAME_DRONE. Enums in constPrefixes keep the prefix
+// they had before they were generated. Anything else is prefixed with its type name in upper snake case.
+// Every field that holds an object is a pointer, required or not.
+// allOf is merged into one struct, or kept as the reference when it only wraps one $ref.
+// oneOf and anyOf become string if every variant is a string, a list if they're one of
+// something or a list of them, and json.RawMessage otherwise.
+// Objects and enums declared inline get a name made from their parent's name and the property's,
+// unless typeNames has a better one.
+// Every operation becomes a method on *Client named after its operationId,
+// with the parameters of its path as well as its own.
+// Responses wrapped as {"data": ...} are unwrapped.
+// The generated code calls Client.call, which lives in a hand-written file.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+type schema struct {
+	Ref         string `json:"$ref"`
+	Type        string
+	Format      string
+	Description string
+	Enum        []string
+	Items       *schema
+	Properties  map[string]*schema
+	Required    []string
+	AllOf       []*schema
+	OneOf       []*schema
+	AnyOf       []*schema
+}
+
+type parameter struct {
+	Name        string
+	In          string
+	Required    bool
+	Description string
+	Schema      *schema
+}
+
+type mediaType struct {
+	Schema *schema
+}
+
+type response struct {
+	Description string
+	Content     map[string]mediaType
+}
+
+type operation struct {
+	OperationId string
+	Summary     string
+	Description string
+	Parameters  []parameter
+	RequestBody *struct {
+		Content map[string]mediaType
+	}
+	Responses map[string]response
+}
+
+// A path and its operations. Parameters listed here apply to every operation under it.
+type pathItem struct {
+	Parameters []parameter
+	Operations map[string]*operation
+}
+
+func (self *pathItem) UnmarshalJSON(data []byte) error {
+	fields := map[string]json.RawMessage{}
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	self.Operations = make(map[string]*operation)
+	for key, value := range fields {
+		switch key {
+		case "parameters":
+			err = json.Unmarshal(value, &self.Parameters)
+		case "get", "put", "post", "delete", "patch", "head", "options", "trace":
+			op := new(operation)
+			err = json.Unmarshal(value, op)
+			self.Operations[key] = op
+		}
+		if err != nil {
+			return fmt.Errorf("Decoding %s. %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+type document struct {
+	Paths      map[string]*pathItem
+	Components struct {
+		Schemas map[string]*schema
+	}
+}
+
+type generator struct {
+	doc     *document
+	buf     *bytes.Buffer
+	pending []namedSchema
+	done    map[string]bool
+	imports map[string]bool
+}
+
+type namedSchema struct {
+	name   string
+	schema *schema
+}
+
+func main() {
+	in := flag.String("in", "SpaceTraders.json", "OpenAPI document")
+	out := flag.String("out", "api_gen.go", "Go file to write")
+	pkg := flag.String("package", "api", "package of the generated file")
+	flag.Parse()
+
+	fileData, err := os.ReadFile(*in)
+	if err != nil {
+		log.Fatalf("openapigen: Reading %s %s", *in, err.Error())
+	}
+
+	doc := new(document)
+	err = json.Unmarshal(fileData, doc)
+	if err != nil {
+		log.Fatalf("openapigen: Decoding JSON from %s %s", *in, err.Error())
+	}
+
+	src, err := generate(*pkg, *in, doc)
+	if err != nil {
+		log.Fatalf("openapigen: %s", err.Error())
+	}
+
+	err = os.WriteFile(*out, src, 0644)
+	if err != nil {
+		log.Fatalf("openapigen: Writing %s %s", *out, err.Error())
+	}
+}
+
+func generate(pkg string, source string, doc *document) ([]byte, error) {
+	g := &generator{
+		doc:     doc,
+		buf:     new(bytes.Buffer),
+		done:    make(map[string]bool),
+		imports: make(map[string]bool),
+	}
+
+	names := []string{}
+	for name := range doc.Components.Schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		g.pending = append(g.pending, namedSchema{name, doc.Components.Schemas[name]})
+	}
+
+	paths := []string{}
+	for path := range doc.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		item := doc.Paths[path]
+		methods := []string{}
+		for method := range item.Operations {
+			methods = append(methods, method)
+		}
+		sort.Strings(methods)
+
+		for _, method := range methods {
+			err := g.operation(path, method, item.Parameters, item.Operations[method])
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for len(g.pending) > 0 {
+		next := g.pending[0]
+		g.pending = g.pending[1:]
+		if g.done[next.name] {
+			continue
+		}
+		g.done[next.name] = true
+
+		err := g.typeDecl(next.name, next.schema)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	header := new(bytes.Buffer)
+	fmt.Fprintf(header, "// Code generated by openapigen from %s. DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(header, "package %s\n\n", pkg)
+	if len(g.imports) > 0 {
+		imports := []string{}
+		for path := range g.imports {
+			imports = append(imports, fmt.Sprintf("%q", path))
+		}
+		sort.Strings(imports)
+		fmt.Fprintf(header, "import (\n%s\n)\n\n", strings.Join(imports, "\n"))
+	}
+	header.Write(g.buf.Bytes())
+
+	src, err := format.Source(header.Bytes())
+	if err != nil {
+		return header.Bytes(), fmt.Errorf("Formatting output. %w", err)
+	}
+
+	return src, nil
+}
+
+// Words Go spells in capitals.
+var initialisms = map[string]string{
+	"Id":  "ID",
+	"Url": "URL",
+}
+
+// Turns "get-jump-gate", "shipSymbol" or "accountId" into GetJumpGate, ShipSymbol, AccountID.
+func exported(name string) string {
+	words := []string{}
+	word := []rune{}
+	upper := true
+	for _, r := range name {
+		if r == '-' || r == '_' || r == ' ' || r == '/' || r == '.' {
+			upper = true
+			continue
+		}
+		if upper || unicode.IsUpper(r) {
+			if len(word) > 0 {
+				words = append(words, string(word))
+			}
+			word = []rune{unicode.ToUpper(r)}
+			upper = false
+		} else {
+			word = append(word, r)
+		}
+	}
+	if len(word) > 0 {
+		words = append(words, string(word))
+	}
+
+	for i, word := range words {
+		if initialism, ok := initialisms[word]; ok {
+			words[i] = initialism
+		}
+	}
+
+	return strings.Join(words, "")
+}
+
+// Names for inline schemas that read better than parent+property,
+// mostly the names the enums had before they were generated.
+// The document lists some goods inline instead of referring to TradeSymbol,
+// so those are pointed back at it.
+var typeNames = map[string]string{
+	"MarketTradeGoodType":      "TradeGoodType",
+	"MarketTransactionType":    "TransactionType",
+	"ShipMountDepositsItem":    "TradeSymbol",
+	"ShipRefineRequestProduce": "TradeSymbol",
+}
+
+// The Go name for a schema declared inline as name.
+func inlineName(name string) string {
+	goName := exported(name)
+	if renamed, ok := typeNames[goName]; ok {
+		return renamed
+	}
+
+	return goName
+}
+
+// Turns ShipNavStatus into SHIP_NAV_STATUS.
+func constPrefix(name string) string {
+	out := []rune{}
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			out = append(out, '_')
+		}
+		out = append(out, unicode.ToUpper(r))
+	}
+
+	return string(out)
+}
+
+func comment(buf *bytes.Buffer, text string) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return
+	}
+	for _, line := range strings.Split(text, "\n") {
+		fmt.Fprintf(buf, "// %s\n", strings.TrimSpace(line))
+	}
+}
+
+// The Go type for a schema used as a field, parameter or result.
+// Inline objects and enums are queued to be declared as name.
+func (self *generator) goType(name string, s *schema) (string, error) {
+	if s == nil {
+		return "any", nil
+	}
+
+	if s.Ref != "" {
+		const prefix = "#/components/schemas/"
+		if !strings.HasPrefix(s.Ref, prefix) {
+			return "", fmt.Errorf("Unsupported $ref %s.", s.Ref)
+		}
+		refName := strings.TrimPrefix(s.Ref, prefix)
+		if _, ok := self.doc.Components.Schemas[refName]; !ok {
+			return "", fmt.Errorf("Missing schema %s.", refName)
+		}
+		return exported(refName), nil
+	}
+
+	if len(s.AllOf) == 1 && len(s.Properties) == 0 {
+		return self.goType(name, s.AllOf[0])
+	}
+	if len(s.AllOf) > 0 {
+		s = self.merge(s)
+	}
+	if len(s.OneOf) > 0 || len(s.AnyOf) > 0 {
+		return self.unionType(name, s)
+	}
+
+	switch s.Type {
+	case "string":
+		if len(s.Enum) > 0 {
+			self.pending = append(self.pending, namedSchema{inlineName(name), s})
+			return inlineName(name), nil
+		}
+		return "string", nil
+	case "integer":
+		return "int", nil
+	case "number":
+		return "float64", nil
+	case "boolean":
+		return "bool", nil
+	case "array":
+		item, err := self.goType(name+"Item", s.Items)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + item, nil
+	case "object":
+		if len(s.Properties) == 0 {
+			return "map[string]any", nil
+		}
+		self.pending = append(self.pending, namedSchema{inlineName(name), s})
+		return inlineName(name), nil
+	}
+
+	return "any", nil
+}
+
+func (self *generator) typeDecl(name string, s *schema) error {
+	goName := exported(name)
+	comment(self.buf, s.Description)
+
+	// allOf around one $ref stays an alias of it.
+	if len(s.AllOf) > 0 && !(len(s.AllOf) == 1 && len(s.Properties) == 0 && s.AllOf[0].Ref != "") {
+		s = self.merge(s)
+	}
+
+	switch {
+	case s.Type == "object" && len(s.Properties) > 0:
+		return self.structDecl(goName, s)
+	case s.Type == "string" && len(s.Enum) > 0:
+		self.enumDecl(goName, s)
+		return nil
+	}
+
+	goType, err := self.goType(name, s)
+	if err != nil {
+		return fmt.Errorf("Schema %s. %w", name, err)
+	}
+	fmt.Fprintf(self.buf, "type %s = %s\n\n", goName, goType)
+
+	return nil
+}
+
+func (self *generator) enumDecl(goName string, s *schema) {
+	self.imports["fmt"] = true
+	plural := goName + "s"
+	if strings.HasSuffix(goName, "s") {
+		plural = goName + "es"
+	}
+	known := "known" + plural
+	prefix := enumPrefix(goName, s.Enum)
+
+	fmt.Fprintf(self.buf, "type %s string\n\n", goName)
+	fmt.Fprintf(self.buf, "const (\n")
+	for _, value := range s.Enum {
+		fmt.Fprintf(self.buf, "%s%s %s = %q\n", prefix, value, goName, value)
+	}
+	fmt.Fprintf(self.buf, ")\n\n")
+
+	fmt.Fprintf(self.buf, "var %s = []%s{\n", known, goName)
+	for _, value := range s.Enum {
+		fmt.Fprintf(self.buf, "%s%s,\n", prefix, value)
+	}
+	fmt.Fprintf(self.buf, "}\n\n")
+
+	fmt.Fprintf(self.buf, "// Every %s this package knows about.\n", goName)
+	fmt.Fprintf(self.buf, "func All%s() []%s {\n", plural, goName)
+	fmt.Fprintf(self.buf, "return append([]%s(nil), %s...)\n}\n\n", goName, known)
+
+	fmt.Fprintf(self.buf, "func (self %s) IsKnown() bool {\n", goName)
+	fmt.Fprintf(self.buf, "for _, value := range %s {\nif self == value {\nreturn true\n}\n}\n\n", known)
+	fmt.Fprintf(self.buf, "return false\n}\n\n")
+
+	fmt.Fprintf(self.buf, "func (self %s) Validate() error {\n", goName)
+	fmt.Fprintf(self.buf, "if self.IsKnown() {\nreturn nil\n}\n\n")
+	fmt.Fprintf(self.buf, "return fmt.Errorf(\"%%w %%q is not a %s.\", UnknownEnumError, string(self))\n}\n\n", goName)
+}
+
+// Prefixes the enum constants had before they were generated.
+var constPrefixes = map[string]string{
+	"FactionSymbol":          "FACTION_",
+	"FactionTraitSymbol":     "FACTION_TRAIT_",
+	"WaypointTraitSymbol":    "WAYPOINT_TRAIT_",
+	"WaypointModifierSymbol": "WAYPOINT_MODIFIER_",
+	"ShipNavStatus":          "NAV_STATUS_",
+	"ShipNavFlightMode":      "FLIGHT_MODE_",
+	"ShipCrewRotation":       "CREW_ROTATION_",
+	"SupplyLevel":            "SUPPLY_",
+	"ActivityLevel":          "ACTIVITY_",
+}
+
+// What goes in front of each value to name an enum's constants.
+// SHIP_PROBE and SHIP_SURVEYOR already say what they are, so ShipType's constants have no prefix.
+func enumPrefix(goName string, values []string) string {
+	if prefix, ok := constPrefixes[goName]; ok {
+		return prefix
+	}
+
+	if len(values) > 1 {
+		first, _, _ := strings.Cut(values[0], "_")
+		shared := true
+		for _, value := range values {
+			if !strings.HasPrefix(value, first+"_") {
+				shared = false
+				break
+			}
+		}
+		if shared {
+			return ""
+		}
+	}
+
+	return constPrefix(goName) + "_"
+}
+
+// Follows $refs, and allOf when it only wraps one schema, to the schema that says what something is.
+// allOf with more parts is merged.
+func (self *generator) resolve(s *schema) *schema {
+	for s != nil {
+		switch {
+		case s.Ref != "":
+			s = self.doc.Components.Schemas[strings.TrimPrefix(s.Ref, "#/components/schemas/")]
+		case len(s.AllOf) == 1 && len(s.Properties) == 0:
+			s = s.AllOf[0]
+		case len(s.AllOf) > 0:
+			return self.merge(s)
+		default:
+			return s
+		}
+	}
+
+	return nil
+}
+
+// One object with the properties and required lists of s and every part of its allOf.
+// If none of them are objects the first part is used as it is.
+func (self *generator) merge(s *schema) *schema {
+	merged := &schema{
+		Type:        "object",
+		Description: s.Description,
+		Properties:  make(map[string]*schema),
+		Required:    append([]string(nil), s.Required...),
+	}
+	for property, propertySchema := range s.Properties {
+		merged.Properties[property] = propertySchema
+	}
+
+	for _, part := range s.AllOf {
+		part := self.resolve(part)
+		if part == nil {
+			continue
+		}
+		for property, propertySchema := range part.Properties {
+			merged.Properties[property] = propertySchema
+		}
+		merged.Required = append(merged.Required, part.Required...)
+		if merged.Description == "" {
+			merged.Description = part.Description
+		}
+	}
+
+	if len(merged.Properties) == 0 && len(s.AllOf) > 0 {
+		if first := self.resolve(s.AllOf[0]); first != nil {
+			return first
+		}
+	}
+
+	return merged
+}
+
+// oneOf and anyOf can't be a Go type, so they're left for the caller to decode.
+// Strings stay strings since that's all they can be,
+// and one of something or a list of them is a list.
+func (self *generator) unionType(name string, s *schema) (string, error) {
+	variants := append(append([]*schema(nil), s.OneOf...), s.AnyOf...)
+	if len(variants) == 2 {
+		for i, variant := range variants {
+			other := variants[1-i]
+			if variant.Type == "array" && variant.Items != nil && other.Ref != "" && variant.Items.Ref == other.Ref {
+				return self.goType(name, variant)
+			}
+		}
+	}
+
+	for _, variant := range variants {
+		if resolved := self.resolve(variant); resolved == nil || resolved.Type != "string" {
+			self.imports["encoding/json"] = true
+			return "json.RawMessage", nil
+		}
+	}
+
+	return "string", nil
+}
+
+func (self *generator) structDecl(goName string, s *schema) error {
+	required := make(map[string]bool)
+	for _, property := range s.Required {
+		required[property] = true
+	}
+
+	properties := []string{}
+	for property := range s.Properties {
+		properties = append(properties, property)
+	}
+	sort.Strings(properties)
+
+	fmt.Fprintf(self.buf, "type %s struct {\n", goName)
+	for _, property := range properties {
+		propertySchema := s.Properties[property]
+		goType, err := self.goType(goName+exported(property), propertySchema)
+		if err != nil {
+			return fmt.Errorf("Schema %s property %s. %w", goName, property, err)
+		}
+
+		tag := property
+		if !required[property] {
+			tag += ",omitempty"
+		}
+		if self.isStruct(propertySchema) {
+			goType = "*" + goType
+		}
+
+		comment(self.buf, propertySchema.Description)
+		fmt.Fprintf(self.buf, "%s %s `json:%q`\n", exported(property), goType, tag)
+	}
+	fmt.Fprintf(self.buf, "}\n\n")
+
+	return nil
+}
+
+func (self *generator) isStruct(s *schema) bool {
+	s = self.resolve(s)
+
+	return s != nil && s.Type == "object" && len(s.Properties) > 0
+}
+
+// The schema of a 2xx JSON response, and whether it's wrapped in {"data": ...}.
+func successSchema(op *operation) (*schema, bool) {
+	codes := []string{}
+	for code := range op.Responses {
+		if strings.HasPrefix(code, "2") {
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) == 0 {
+		return nil, false
+	}
+	sort.Strings(codes)
+
+	s := op.Responses[codes[0]].Content["application/json"].Schema
+	if s == nil {
+		return nil, false
+	}
+	if data, ok := s.Properties["data"]; ok && len(s.Properties) == 1 {
+		return data, true
+	}
+
+	return s, false
+}
+
+func (self *generator) operation(path string, method string, pathParams []parameter, op *operation) error {
+	if op.OperationId == "" {
+		return fmt.Errorf("%s %s has no operationId.", strings.ToUpper(method), path)
+	}
+	goName := exported(op.OperationId)
+	self.imports["net/url"] = true
+
+	args := []string{}
+	pathExpr := "\"" + path + "\""
+	queryLines := []string{}
+
+	// The operation's own parameters replace the path's ones with the same name.
+	params := []parameter{}
+	for _, param := range pathParams {
+		overridden := false
+		for _, own := range op.Parameters {
+			if own.Name == param.Name && own.In == param.In {
+				overridden = true
+			}
+		}
+		if !overridden {
+			params = append(params, param)
+		}
+	}
+	params = append(params, op.Parameters...)
+
+	for _, param := range params {
+		argName := exported(param.Name)
+		argName = strings.ToLower(argName[:1]) + argName[1:]
+		if token.IsKeyword(argName) {
+			argName += "Param"
+		}
+
+		goType, err := self.goType(goName+exported(param.Name), param.Schema)
+		if err != nil {
+			return fmt.Errorf("%s parameter %s. %w", goName, param.Name, err)
+		}
+
+		switch param.In {
+		case "path":
+			args = append(args, argName+" "+goType)
+			pathExpr = strings.Replace(
+				pathExpr,
+				"{"+param.Name+"}",
+				"\"+url.PathEscape(string("+argName+"))+\"",
+				1,
+			)
+		case "query":
+			args = append(args, argName+" "+goType)
+			switch goType {
+			case "int":
+				self.imports["strconv"] = true
+				queryLines = append(queryLines, fmt.Sprintf(
+					"if %s != 0 {\nquery.Set(%q, strconv.Itoa(%s))\n}",
+					argName, param.Name, argName,
+				))
+			case "bool":
+				queryLines = append(queryLines, fmt.Sprintf(
+					"if %s {\nquery.Set(%q, \"true\")\n}",
+					argName, param.Name,
+				))
+			case "json.RawMessage":
+				queryLines = append(queryLines, fmt.Sprintf(
+					"if len(%s) > 0 {\nquery.Set(%q, string(%s))\n}",
+					argName, param.Name, argName,
+				))
+			default:
+				if strings.HasPrefix(goType, "[]") {
+					queryLines = append(queryLines, fmt.Sprintf(
+						"for _, value := range %s {\nquery.Add(%q, string(value))\n}",
+						argName, param.Name,
+					))
+					break
+				}
+				queryLines = append(queryLines, fmt.Sprintf(
+					"if %s != \"\" {\nquery.Set(%q, string(%s))\n}",
+					argName, param.Name, argName,
+				))
+			}
+		}
+	}
+	pathExpr = strings.TrimSuffix(pathExpr, "+\"\"")
+
+	bodyArg := "nil"
+	if op.RequestBody != nil {
+		if body := op.RequestBody.Content["application/json"].Schema; body != nil {
+			goType, err := self.goType(goName+"Request", body)
+			if err != nil {
+				return fmt.Errorf("%s request body. %w", goName, err)
+			}
+			if self.isStruct(body) {
+				goType = "*" + goType
+			}
+			args = append(args, "body "+goType)
+			bodyArg = "body"
+		}
+	}
+
+	resultType := ""
+	result, wrapped := successSchema(op)
+	if result != nil {
+		goType, err := self.goType(goName+"Response", result)
+		if err != nil {
+			return fmt.Errorf("%s response. %w", goName, err)
+		}
+		resultType = goType
+	}
+
+	summary := op.Summary
+	if summary == "" {
+		summary = goName
+	}
+	comment(self.buf, fmt.Sprintf("%s\n%s %s", summary, strings.ToUpper(method), path))
+
+	if resultType == "" {
+		fmt.Fprintf(self.buf, "func (self *Client) %s(%s) error {\n", goName, strings.Join(args, ", "))
+	} else {
+		fmt.Fprintf(self.buf, "func (self *Client) %s(%s) (*%s, error) {\n", goName, strings.Join(args, ", "), resultType)
+	}
+
+	if len(queryLines) > 0 {
+		fmt.Fprintf(self.buf, "query := url.Values{}\n%s\n", strings.Join(queryLines, "\n"))
+	} else {
+		fmt.Fprintf(self.buf, "var query url.Values\n")
+	}
+
+	if resultType == "" {
+		fmt.Fprintf(
+			self.buf,
+			"return self.call(%q, %s, query, %s, nil, false)\n}\n\n",
+			strings.ToUpper(method), pathExpr, bodyArg,
+		)
+		return nil
+	}
+
+	fmt.Fprintf(self.buf, "result := new(%s)\n", resultType)
+	fmt.Fprintf(
+		self.buf,
+		"err := self.call(%q, %s, query, %s, result, %t)\n",
+		strings.ToUpper(method), pathExpr, bodyArg, wrapped,
+	)
+	fmt.Fprintf(self.buf, "if err != nil {\nreturn nil, err\n}\n\nreturn result, nil\n}\n\n")
+
+	return nil
+}
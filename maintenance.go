@@ -0,0 +1,153 @@
+package space_traders_api
+
+import (
+	"fmt"
+	"math"
+)
+
+// A repair or scrap, quoted or done.
+type ShipyardTransaction struct {
+	WaypointSymbol string
+	ShipSymbol     string
+	TotalPrice     int
+	Timestamp      string
+}
+
+type RepairResult struct {
+	Agent       *Agent
+	Ship        *Ship
+	Transaction *ShipyardTransaction
+}
+
+type ScrapResult struct {
+	Agent       *Agent
+	Transaction *ShipyardTransaction
+}
+
+// What a full repair would cost at the shipyard the ship is docked at.
+func GetRepairQuote(shipSymbol string, token string) (*ShipyardTransaction, error) {
+	respObject := new(struct{ Transaction *ShipyardTransaction })
+
+	err := shipAction("GET", shipSymbol, "repair", token, nil, respObject)
+	if err != nil {
+		return nil, err
+	}
+	if respObject.Transaction == nil {
+		return nil, fmt.Errorf("Getting repair quote for %s. %w", shipSymbol, NoContentError)
+	}
+
+	return respObject.Transaction, nil
+}
+
+// Repairs the ship at the shipyard it's docked at.
+func RepairShip(shipSymbol string, token string) (*RepairResult, error) {
+	result := new(RepairResult)
+
+	err := shipAction("POST", shipSymbol, "repair", token, nil, result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Transaction == nil {
+		return result, fmt.Errorf("Repairing %s. %w", shipSymbol, NoContentError)
+	}
+
+	return result, nil
+}
+
+// What the shipyard the ship is docked at would pay to scrap it.
+func GetScrapQuote(shipSymbol string, token string) (*ShipyardTransaction, error) {
+	respObject := new(struct{ Transaction *ShipyardTransaction })
+
+	err := shipAction("GET", shipSymbol, "scrap", token, nil, respObject)
+	if err != nil {
+		return nil, err
+	}
+	if respObject.Transaction == nil {
+		return nil, fmt.Errorf("Getting scrap quote for %s. %w", shipSymbol, NoContentError)
+	}
+
+	return respObject.Transaction, nil
+}
+
+// Scraps the ship. It's gone after this.
+func ScrapShip(shipSymbol string, token string) (*ScrapResult, error) {
+	result := new(ScrapResult)
+
+	err := shipAction("POST", shipSymbol, "scrap", token, nil, result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Transaction == nil {
+		return result, fmt.Errorf("Scrapping %s. %w", shipSymbol, NoContentError)
+	}
+
+	return result, nil
+}
+
+// The worst condition of the ship's frame, reactor and engine, from 0 to 1.
+// Returns 1 if the ship has none of them.
+func (self *Ship) LowestCondition() float64 {
+	lowest := 1.0
+
+	if self.Frame != nil {
+		lowest = math.Min(lowest, self.Frame.Condition)
+	}
+	if self.Reactor != nil {
+		lowest = math.Min(lowest, self.Reactor.Condition)
+	}
+	if self.Engine != nil {
+		lowest = math.Min(lowest, self.Engine.Condition)
+	}
+
+	return lowest
+}
+
+// When ships are worth repairing.
+type MaintenancePolicy struct {
+	// Repair once any of frame, reactor or engine falls below this condition (0 to 1).
+	ConditionThreshold float64
+	// Credits that must be left over after paying for a repair.
+	MinCredits int
+}
+
+var DefaultMaintenancePolicy = MaintenancePolicy{
+	ConditionThreshold: 0.5,
+	MinCredits:         10000,
+}
+
+// Decides whether to repair ship for repairCost, given the agent has credits.
+// The reason says why, either way.
+func (self MaintenancePolicy) ShouldRepair(
+	ship *Ship,
+	repairCost int,
+	credits int,
+) (repair bool, reason string) {
+	condition := ship.LowestCondition()
+
+	if condition >= self.ConditionThreshold {
+		return false, fmt.Sprintf(
+			"%s condition %.2f is above threshold %.2f.",
+			ship.Symbol,
+			condition,
+			self.ConditionThreshold,
+		)
+	}
+
+	if credits-repairCost < self.MinCredits {
+		return false, fmt.Sprintf(
+			"%s needs repair (condition %.2f) but %dc would leave %dc, below the %dc reserve.",
+			ship.Symbol,
+			condition,
+			repairCost,
+			credits-repairCost,
+			self.MinCredits,
+		)
+	}
+
+	return true, fmt.Sprintf(
+		"%s condition %.2f is below threshold %.2f.",
+		ship.Symbol,
+		condition,
+		self.ConditionThreshold,
+	)
+}
@@ -0,0 +1,250 @@
+package space_traders_api
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/brendoncdodd/space_traders_api/api"
+)
+
+// Generated from the OpenAPI document.
+// Prices and supply are only shown when one of the agent's ships is at the market.
+type (
+	TradeGood         = api.TradeGood
+	MarketTradeGood   = api.MarketTradeGood
+	MarketTransaction = api.MarketTransaction
+)
+
+// A market at a waypoint, as seen at ObservedAt.
+type Market struct {
+	api.Market
+	ObservedAt time.Time
+}
+
+func tradeGoodsInclude(goods []TradeGood, tradeSymbol TradeSymbol) bool {
+	for _, good := range goods {
+		if good.Symbol == tradeSymbol {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self *Market) ExportsGood(tradeSymbol TradeSymbol) bool {
+	return tradeGoodsInclude(self.Exports, tradeSymbol)
+}
+
+func (self *Market) ImportsGood(tradeSymbol TradeSymbol) bool {
+	return tradeGoodsInclude(self.Imports, tradeSymbol)
+}
+
+// Whether the market buys or sells tradeSymbol at all.
+func (self *Market) Trades(tradeSymbol TradeSymbol) bool {
+	return self.ExportsGood(tradeSymbol) ||
+		self.ImportsGood(tradeSymbol) ||
+		tradeGoodsInclude(self.Exchange, tradeSymbol)
+}
+
+// Whether a ship can buy tradeSymbol here: the market exports or exchanges it,
+// or has a purchase price for it.
+func (self *Market) Sells(tradeSymbol TradeSymbol) bool {
+	if self.ExportsGood(tradeSymbol) || tradeGoodsInclude(self.Exchange, tradeSymbol) {
+		return true
+	}
+
+	good := self.TradeGood(tradeSymbol)
+
+	return good != nil && good.PurchasePrice > 0
+}
+
+// The price details for tradeSymbol, or nil if there are none.
+func (self *Market) TradeGood(tradeSymbol TradeSymbol) *MarketTradeGood {
+	for i := range self.TradeGoods {
+		if self.TradeGoods[i].Symbol == tradeSymbol {
+			return &self.TradeGoods[i]
+		}
+	}
+
+	return nil
+}
+
+// https://api.spacetraders.io/v2/systems/{systemSymbol}/waypoints/{waypointSymbol}/market
+func GetMarket(waypointSymbol string, token string) (*Market, error) {
+	errPrefix := "Getting market " + waypointSymbol + "."
+	respObject := new(struct {
+		Data  *Market
+		Error *STJsonError
+	})
+	symbol, err := ParseWaypointSymbol(waypointSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", errPrefix, err)
+	}
+	systemSymbol := symbol.SystemSymbol()
+
+	req, err := newRequest(
+		"GET",
+		"/systems/"+systemSymbol+"/waypoints/"+waypointSymbol+"/market",
+		token,
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", errPrefix, err)
+	}
+	defer req.Body.Close()
+
+	buf, err := SendRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Sending request.\n%w",
+			errPrefix,
+			err,
+		)
+	}
+
+	err = json.Unmarshal(buf, respObject)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Unmarshaling response.\n%w",
+			errPrefix,
+			err,
+		)
+	}
+	if respObject.Error != nil {
+		return respObject.Data, fmt.Errorf(
+			"%s spacetraders.io error. %w",
+			errPrefix,
+			respObject.Error,
+		)
+	}
+	if respObject.Data == nil {
+		return nil, fmt.Errorf(
+			"%s %w",
+			errPrefix,
+			NoContentError,
+		)
+	}
+
+	respObject.Data.ObservedAt = time.Now()
+
+	return respObject.Data, nil
+}
+
+// Gets the market at every MARKETPLACE waypoint in a system.
+func GetSystemMarkets(systemSymbol string, token string) (markets []Market, err error) {
+	errPrefix := "Getting markets in " + systemSymbol + "."
+
+	waypoints, err := GetAllWaypointsInSystem(systemSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", errPrefix, err)
+	}
+
+	for _, waypoint := range waypoints {
+		if !waypoint.HasTrait(WAYPOINT_TRAIT_MARKETPLACE) {
+			continue
+		}
+
+		market, err := GetMarket(waypoint.Symbol, token)
+		if err != nil {
+			return markets, fmt.Errorf("%s %w", errPrefix, err)
+		}
+
+		markets = append(markets, *market)
+	}
+
+	return markets, nil
+}
+
+type TradeResult struct {
+	Agent       *Agent
+	Cargo       *ShipCargo
+	Transaction *MarketTransaction
+}
+
+func trade(
+	action string,
+	shipSymbol string,
+	tradeSymbol TradeSymbol,
+	units int,
+	token string,
+) (*TradeResult, error) {
+	result := new(TradeResult)
+
+	err := shipAction(
+		"POST",
+		shipSymbol,
+		action,
+		token,
+		map[string]any{"symbol": tradeSymbol, "units": units},
+		result,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if result.Cargo == nil {
+		return result, fmt.Errorf(
+			"Trying to %s %d %s with %s. %w",
+			action,
+			units,
+			tradeSymbol,
+			shipSymbol,
+			NoContentError,
+		)
+	}
+
+	return result, nil
+}
+
+// Sells cargo at the market the ship is docked at.
+// units can't be more than the good's TradeVolume.
+func SellCargo(
+	shipSymbol string,
+	tradeSymbol TradeSymbol,
+	units int,
+	token string,
+) (*TradeResult, error) {
+	return trade("sell", shipSymbol, tradeSymbol, units, token)
+}
+
+// Buys cargo at the market the ship is docked at.
+// units can't be more than the good's TradeVolume.
+func PurchaseCargo(
+	shipSymbol string,
+	tradeSymbol TradeSymbol,
+	units int,
+	token string,
+) (*TradeResult, error) {
+	return trade("purchase", shipSymbol, tradeSymbol, units, token)
+}
+
+type RefuelResult struct {
+	Agent       *Agent
+	Fuel        *ShipFuel
+	Transaction *MarketTransaction
+}
+
+// Refuels the ship at the market it's docked at.
+// Pass 0 units to fill the tank. With fromCargo the fuel comes out of the ship's cargo.
+func RefuelShip(
+	shipSymbol string,
+	units int,
+	fromCargo bool,
+	token string,
+) (*RefuelResult, error) {
+	result := new(RefuelResult)
+	body := map[string]any{"fromCargo": fromCargo}
+	if units > 0 {
+		body["units"] = units
+	}
+
+	err := shipAction("POST", shipSymbol, "refuel", token, body, result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Fuel == nil {
+		return result, fmt.Errorf("Refueling %s. %w", shipSymbol, NoContentError)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,407 @@
+package space_traders_api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+// A ready made Behavior for mining ships.
+// Surveys the asteroid if the ship can, extracts with the best survey until the hold is full,
+// delivers anything Contract still needs, sells the rest at the best nearby market,
+// refuels there and goes back.
+type MiningBehavior struct {
+	// Waypoint to mine. If empty, the nearest waypoint with AsteroidTraits is used.
+	Asteroid       string
+	AsteroidTraits []WaypointTraitSymbol
+	// Whether a good is worth keeping. Anything else is jettisoned after extracting.
+	// nil keeps everything.
+	Keep func(tradeSymbol TradeSymbol) bool
+	// Goods this contract still needs are delivered instead of sold. Can be nil.
+	Contract *Contract
+	// Markets further than this from the asteroid aren't sold at. 0 for no limit.
+	MaxSellDistance float64
+	// How full the hold gets (0 to 1) before going to sell. 0 means completely full.
+	FullAt float64
+
+	surveys []Survey
+	markets map[string]*Market
+	// From the asteroid to each market, worked out once when the markets are loaded.
+	distances map[string]float64
+	selling   bool
+}
+
+var DefaultAsteroidTraits = []WaypointTraitSymbol{WAYPOINT_TRAIT_COMMON_METAL_DEPOSITS}
+
+func (self *MiningBehavior) keeps(tradeSymbol TradeSymbol) bool {
+	return self.Keep == nil || self.Keep(tradeSymbol)
+}
+
+// Units of a good the contract still needs, up to what's in the hold.
+func (self *MiningBehavior) reserved(ship *Ship, tradeSymbol TradeSymbol) int {
+	if self.Contract == nil || self.Contract.Fulfilled {
+		return 0
+	}
+
+	return min(ship.Cargo.UnitsOf(tradeSymbol), self.Contract.Remaining(tradeSymbol))
+}
+
+func (self *MiningBehavior) full(ship *Ship) bool {
+	if ship.Cargo == nil || ship.Cargo.Capacity == 0 {
+		return false
+	}
+	if self.FullAt <= 0 || self.FullAt >= 1 {
+		return ship.Cargo.Free() <= 0
+	}
+
+	return float64(ship.Cargo.Units) >= self.FullAt*float64(ship.Cargo.Capacity)
+}
+
+func (self *MiningBehavior) Step(ctx context.Context, ship *Ship, token string) (BehaviorStep, error) {
+	errPrefix := "Mining with " + ship.Symbol + "."
+
+	if self.Asteroid == "" {
+		traits := self.AsteroidTraits
+		if len(traits) == 0 {
+			traits = DefaultAsteroidTraits
+		}
+
+		asteroid, err := FindNearestWaypointWithTraits(ship.Symbol, traits, token)
+		if err != nil {
+			return BehaviorStep{}, fmt.Errorf("%s Finding an asteroid.\n%w", errPrefix, err)
+		}
+		self.Asteroid = asteroid
+	}
+
+	if self.selling || self.full(ship) {
+		self.selling = true
+
+		step, err := self.unload(ship, token)
+		if err != nil {
+			return step, fmt.Errorf("%s %w", errPrefix, err)
+		}
+		return step, nil
+	}
+
+	arrived, step, err := moveTo(ship, self.Asteroid, token)
+	if err != nil {
+		return step, fmt.Errorf("%s %w", errPrefix, err)
+	}
+	if !arrived {
+		return step, nil
+	}
+
+	err = ensureOrbit(ship, token)
+	if err != nil {
+		return BehaviorStep{}, fmt.Errorf("%s %w", errPrefix, err)
+	}
+
+	if ship.Cooldown.ExpiresAt().After(time.Now()) {
+		return BehaviorStep{Action: "waiting for cooldown", AfterCooldown: true}, nil
+	}
+
+	survey := self.bestSurvey()
+	if survey == nil && ship.CanSurvey() {
+		surveys, cooldown, err := CreateSurvey(ship.Symbol, token)
+		if err != nil {
+			return BehaviorStep{}, fmt.Errorf("%s %w", errPrefix, err)
+		}
+		ship.Cooldown = cooldown
+		self.surveys = append(self.surveys, surveys...)
+
+		return BehaviorStep{
+			Action:        fmt.Sprintf("surveyed %s, %d surveys", self.Asteroid, len(surveys)),
+			AfterCooldown: true,
+		}, nil
+	}
+
+	result, err := ExtractResources(ship.Symbol, survey, token)
+	if err != nil {
+		// Most likely exhausted. Don't try it again.
+		if survey != nil {
+			self.dropSurvey(survey.Signature)
+		}
+		return BehaviorStep{}, fmt.Errorf("%s %w", errPrefix, err)
+	}
+	ship.Cargo = result.Cargo
+	ship.Cooldown = result.Cooldown
+
+	yield := result.Extraction.Yield
+	action := fmt.Sprintf("extracted %d %s", yield.Units, yield.Symbol)
+
+	if !self.keeps(yield.Symbol) && self.reserved(ship, yield.Symbol) == 0 {
+		cargo, err := JettisonCargo(ship.Symbol, yield.Symbol, ship.Cargo.UnitsOf(yield.Symbol), token)
+		if err != nil {
+			return BehaviorStep{}, fmt.Errorf("%s %w", errPrefix, err)
+		}
+		ship.Cargo = cargo
+		action += ", jettisoned"
+	}
+
+	return BehaviorStep{Action: action, AfterCooldown: true}, nil
+}
+
+// The unexpired survey with the most of the goods we keep, weighted by size.
+// nil if none of them has anything we want.
+func (self *MiningBehavior) bestSurvey() *Survey {
+	sizes := map[SurveySize]float64{
+		SURVEY_SIZE_SMALL:    1,
+		SURVEY_SIZE_MODERATE: 2,
+		SURVEY_SIZE_LARGE:    3,
+	}
+
+	fresh := []Survey{}
+	for _, survey := range self.surveys {
+		if !survey.Expired() {
+			fresh = append(fresh, survey)
+		}
+	}
+	self.surveys = fresh
+
+	var best *Survey
+	bestScore := 0.0
+	for i := range self.surveys {
+		survey := &self.surveys[i]
+
+		wanted := []TradeSymbol{}
+		for _, deposit := range survey.Deposits {
+			tradeSymbol := TradeSymbol(deposit.Symbol)
+			if self.keeps(tradeSymbol) ||
+				(self.Contract != nil && self.Contract.Remaining(tradeSymbol) > 0) {
+				wanted = append(wanted, tradeSymbol)
+			}
+		}
+
+		size, ok := sizes[survey.Size]
+		if !ok {
+			size = 1
+		}
+
+		score := survey.Share(wanted) * size
+		if score > bestScore {
+			best = survey
+			bestScore = score
+		}
+	}
+
+	return best
+}
+
+func (self *MiningBehavior) dropSurvey(signature string) {
+	kept := []Survey{}
+	for _, survey := range self.surveys {
+		if survey.Signature != signature {
+			kept = append(kept, survey)
+		}
+	}
+	self.surveys = kept
+}
+
+// Delivers contract goods, then sells everything else one market at a time.
+// Stops selling once the hold has nothing left to sell.
+func (self *MiningBehavior) unload(ship *Ship, token string) (BehaviorStep, error) {
+	if self.Contract != nil && !self.Contract.Fulfilled {
+		for _, deliver := range self.Contract.Terms.Deliver {
+			tradeSymbol := TradeSymbol(deliver.TradeSymbol)
+			units := self.reserved(ship, tradeSymbol)
+			if units <= 0 {
+				continue
+			}
+
+			arrived, step, err := moveTo(ship, deliver.DestinationSymbol, token)
+			if err != nil || !arrived {
+				return step, err
+			}
+			err = ensureDocked(ship, token)
+			if err != nil {
+				return BehaviorStep{}, err
+			}
+
+			contract, cargo, err := DeliverContract(
+				self.Contract.ID,
+				ship.Symbol,
+				tradeSymbol,
+				units,
+				token,
+			)
+			if err != nil {
+				return BehaviorStep{}, err
+			}
+			*self.Contract = *contract
+			ship.Cargo = cargo
+
+			return BehaviorStep{
+				Action: fmt.Sprintf("delivered %d %s to %s", units, tradeSymbol, self.Contract.ID),
+			}, nil
+		}
+	}
+
+	if ship.Cargo == nil {
+		self.selling = false
+		return BehaviorStep{Action: "no cargo hold"}, nil
+	}
+
+	err := self.loadMarkets(token)
+	if err != nil {
+		return BehaviorStep{}, err
+	}
+
+	for _, item := range ship.Cargo.Inventory {
+		units := item.Units - self.reserved(ship, item.Symbol)
+		if units <= 0 {
+			continue
+		}
+
+		marketSymbol := self.bestMarket(item.Symbol)
+		if marketSymbol == "" {
+			cargo, err := JettisonCargo(ship.Symbol, item.Symbol, units, token)
+			if err != nil {
+				return BehaviorStep{}, err
+			}
+			ship.Cargo = cargo
+
+			return BehaviorStep{
+				Action: fmt.Sprintf("jettisoned %d %s, nowhere to sell it", units, item.Symbol),
+			}, nil
+		}
+
+		arrived, step, err := moveTo(ship, marketSymbol, token)
+		if err != nil || !arrived {
+			return step, err
+		}
+
+		return self.sellHere(ship, token)
+	}
+
+	self.selling = false
+
+	return BehaviorStep{Action: "hold empty, going back to " + self.Asteroid}, nil
+}
+
+// Sells everything the market at the ship's waypoint buys, then refuels.
+func (self *MiningBehavior) sellHere(ship *Ship, token string) (BehaviorStep, error) {
+	err := ensureDocked(ship, token)
+	if err != nil {
+		return BehaviorStep{}, err
+	}
+
+	market, err := GetMarket(ship.Nav.WaypointSymbol, token)
+	if err != nil {
+		return BehaviorStep{}, err
+	}
+	self.markets[market.Symbol] = market
+
+	earned := 0
+	for _, item := range append([]ShipCargoItem{}, ship.Cargo.Inventory...) {
+		good := market.TradeGood(item.Symbol)
+		if good == nil {
+			continue
+		}
+
+		units := item.Units - self.reserved(ship, item.Symbol)
+		for units > 0 {
+			batch := units
+			if good.TradeVolume > 0 && batch > good.TradeVolume {
+				batch = good.TradeVolume
+			}
+
+			result, err := SellCargo(ship.Symbol, item.Symbol, batch, token)
+			if err != nil {
+				return BehaviorStep{}, err
+			}
+			ship.Cargo = result.Cargo
+			if result.Transaction != nil {
+				earned += result.Transaction.TotalPrice
+			}
+			units -= batch
+		}
+	}
+
+	if market.Trades(TRADE_SYMBOL_FUEL) && ship.Fuel != nil && ship.Fuel.Current < ship.Fuel.Capacity {
+		result, err := RefuelShip(ship.Symbol, 0, false, token)
+		if err != nil {
+			return BehaviorStep{}, err
+		}
+		ship.Fuel = result.Fuel
+	}
+
+	return BehaviorStep{Action: fmt.Sprintf("sold at %s for %dc", market.Symbol, earned)}, nil
+}
+
+// Gets the markets in the asteroid's system with token, so the ones with our ships
+// there come with prices, and how far each is from the asteroid.
+// Markets whose location can't be found are never sold at.
+func (self *MiningBehavior) loadMarkets(token string) error {
+	if self.markets != nil {
+		return nil
+	}
+
+	symbol, err := ParseWaypointSymbol(self.Asteroid)
+	if err != nil {
+		return err
+	}
+
+	asteroid, err := GetWaypointLocation(self.Asteroid)
+	if err != nil {
+		return err
+	}
+
+	markets, err := GetSystemMarkets(symbol.SystemSymbol(), token)
+	if err != nil {
+		return err
+	}
+
+	self.markets = make(map[string]*Market)
+	self.distances = make(map[string]float64)
+	for i := range markets {
+		self.markets[markets[i].Symbol] = &markets[i]
+
+		location, err := GetWaypointLocation(markets[i].Symbol)
+		if err != nil {
+			continue
+		}
+		self.distances[markets[i].Symbol] = asteroid.Distance(location)
+	}
+
+	return nil
+}
+
+// The market that pays most for a good, as far as we've seen.
+// Markets we haven't seen prices at count as paying nothing, so the nearest wins.
+// A market we've been to with no price for the good doesn't buy it, whatever it lists,
+// so it's skipped rather than flown back to forever.
+func (self *MiningBehavior) bestMarket(tradeSymbol TradeSymbol) string {
+	best := ""
+	bestPrice := -1
+	bestDistance := math.Inf(1)
+	for symbol, market := range self.markets {
+		if !market.Trades(tradeSymbol) {
+			continue
+		}
+		if len(market.TradeGoods) > 0 && market.TradeGood(tradeSymbol) == nil {
+			continue
+		}
+
+		distance, ok := self.distances[symbol]
+		if !ok {
+			continue
+		}
+		if self.MaxSellDistance > 0 && distance > self.MaxSellDistance {
+			continue
+		}
+
+		price := 0
+		if good := market.TradeGood(tradeSymbol); good != nil {
+			price = good.SellPrice
+		}
+
+		if price > bestPrice || (price == bestPrice && distance < bestDistance) {
+			best = symbol
+			bestPrice = price
+			bestDistance = distance
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,234 @@
+package space_traders_api
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/brendoncdodd/space_traders_api/api"
+)
+
+// Generated from the OpenAPI document.
+type ShipModificationTransaction = api.ShipModificationTransaction
+
+var RequirementsError = fmt.Errorf("Ship can't meet requirements.")
+
+// The ship's modules or mounts after an install or remove, whichever was changed.
+type ShipModificationResult struct {
+	Agent       *Agent
+	Modules     []ShipModule
+	Mounts      []ShipMount
+	Cargo       *ShipCargo
+	Transaction *ShipModificationTransaction
+}
+
+func addRequirements(total *ShipRequirements, other *ShipRequirements) {
+	if other == nil {
+		return
+	}
+
+	total.Power += other.Power
+	total.Crew += other.Crew
+	total.Slots += other.Slots
+}
+
+// Everything the ship's frame, reactor, engine, modules and mounts need together.
+func (self *Ship) TotalRequirements() ShipRequirements {
+	total := ShipRequirements{}
+
+	if self.Frame != nil {
+		addRequirements(&total, self.Frame.Requirements)
+	}
+	if self.Reactor != nil {
+		addRequirements(&total, self.Reactor.Requirements)
+	}
+	if self.Engine != nil {
+		addRequirements(&total, self.Engine.Requirements)
+	}
+	for _, module := range self.Modules {
+		addRequirements(&total, module.Requirements)
+	}
+	for _, mount := range self.Mounts {
+		addRequirements(&total, mount.Requirements)
+	}
+
+	return total
+}
+
+// Checks the ship could carry extra on top of what it already needs.
+// Crew quarters add their capacity to the crew the ship can hold.
+func (self *Ship) checkRequirements(extra *ShipRequirements, extraCrewCapacity int) error {
+	if self.Frame == nil || self.Reactor == nil {
+		return fmt.Errorf(
+			"Checking requirements of %s. Ship has no frame or reactor details.",
+			self.Symbol,
+		)
+	}
+
+	total := self.TotalRequirements()
+	addRequirements(&total, extra)
+
+	if total.Power > self.Reactor.PowerOutput {
+		return fmt.Errorf(
+			"%w %s needs %d power, reactor makes %d.",
+			RequirementsError,
+			self.Symbol,
+			total.Power,
+			self.Reactor.PowerOutput,
+		)
+	}
+
+	if total.Slots > self.Frame.ModuleSlots {
+		return fmt.Errorf(
+			"%w %s needs %d module slots, frame has %d.",
+			RequirementsError,
+			self.Symbol,
+			total.Slots,
+			self.Frame.ModuleSlots,
+		)
+	}
+
+	if self.Crew != nil && total.Crew > self.Crew.Capacity+extraCrewCapacity {
+		return fmt.Errorf(
+			"%w %s needs %d crew, has room for %d.",
+			RequirementsError,
+			self.Symbol,
+			total.Crew,
+			self.Crew.Capacity+extraCrewCapacity,
+		)
+	}
+
+	return nil
+}
+
+// Checks locally that module would fit on the ship.
+func (self *Ship) ValidateModuleInstall(module ShipModule) error {
+	extraCrewCapacity := 0
+	if strings.HasPrefix(string(module.Symbol), "MODULE_CREW_QUARTERS") {
+		extraCrewCapacity = module.Capacity
+	}
+
+	return self.checkRequirements(module.Requirements, extraCrewCapacity)
+}
+
+// Checks locally that mount would fit on the ship.
+func (self *Ship) ValidateMountInstall(mount ShipMount) error {
+	if self.Frame != nil && len(self.Mounts)+1 > self.Frame.MountingPoints {
+		return fmt.Errorf(
+			"%w %s has %d mounting points, all in use.",
+			RequirementsError,
+			self.Symbol,
+			self.Frame.MountingPoints,
+		)
+	}
+
+	return self.checkRequirements(mount.Requirements, 0)
+}
+
+// part is "modules" or "mounts", action is "install" or "remove".
+func modifyShip(
+	shipSymbol string,
+	part string,
+	action string,
+	symbol string,
+	token string,
+) (*ShipModificationResult, error) {
+	result := new(ShipModificationResult)
+
+	err := shipAction(
+		"POST",
+		shipSymbol,
+		part+"/"+action,
+		token,
+		map[string]string{"symbol": symbol},
+		result,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Gets the modules installed on a ship.
+func GetShipModules(shipSymbol string, token string) (modules []ShipModule, err error) {
+	err = shipAction("GET", shipSymbol, "modules", token, nil, &modules)
+	return modules, err
+}
+
+// Gets the mounts installed on a ship.
+func GetShipMounts(shipSymbol string, token string) (mounts []ShipMount, err error) {
+	err = shipAction("GET", shipSymbol, "mounts", token, nil, &mounts)
+	return mounts, err
+}
+
+// Installs a module from the ship's cargo, after checking it fits.
+// The ship must be docked at a shipyard. ship.Modules is updated on success.
+func InstallModule(ship *Ship, module ShipModule, token string) (*ShipModificationResult, error) {
+	err := ship.ValidateModuleInstall(module)
+	if err != nil {
+		return nil, fmt.Errorf("Trying to install %s. %w", module.Symbol, err)
+	}
+
+	result, err := modifyShip(ship.Symbol, "modules", "install", string(module.Symbol), token)
+	if err != nil {
+		return result, err
+	}
+
+	ship.Modules = result.Modules
+	if result.Cargo != nil {
+		ship.Cargo = result.Cargo
+	}
+
+	return result, nil
+}
+
+// Removes a module into the ship's cargo. ship.Modules is updated on success.
+func RemoveModule(ship *Ship, moduleSymbol ShipModuleSymbol, token string) (*ShipModificationResult, error) {
+	result, err := modifyShip(ship.Symbol, "modules", "remove", string(moduleSymbol), token)
+	if err != nil {
+		return result, err
+	}
+
+	ship.Modules = result.Modules
+	if result.Cargo != nil {
+		ship.Cargo = result.Cargo
+	}
+
+	return result, nil
+}
+
+// Installs a mount from the ship's cargo, after checking it fits.
+// The ship must be docked at a shipyard. ship.Mounts is updated on success.
+func InstallMount(ship *Ship, mount ShipMount, token string) (*ShipModificationResult, error) {
+	err := ship.ValidateMountInstall(mount)
+	if err != nil {
+		return nil, fmt.Errorf("Trying to install %s. %w", mount.Symbol, err)
+	}
+
+	result, err := modifyShip(ship.Symbol, "mounts", "install", string(mount.Symbol), token)
+	if err != nil {
+		return result, err
+	}
+
+	ship.Mounts = result.Mounts
+	if result.Cargo != nil {
+		ship.Cargo = result.Cargo
+	}
+
+	return result, nil
+}
+
+// Removes a mount into the ship's cargo. ship.Mounts is updated on success.
+func RemoveMount(ship *Ship, mountSymbol ShipMountSymbol, token string) (*ShipModificationResult, error) {
+	result, err := modifyShip(ship.Symbol, "mounts", "remove", string(mountSymbol), token)
+	if err != nil {
+		return result, err
+	}
+
+	ship.Mounts = result.Mounts
+	if result.Cargo != nil {
+		ship.Cargo = result.Cargo
+	}
+
+	return result, nil
+}
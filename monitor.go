@@ -0,0 +1,183 @@
+package space_traders_api
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Kinds of FleetEvent.
+const (
+	FLEET_EVENT_UNDERCREWED = "UNDERCREWED"
+	FLEET_EVENT_LOW_MORALE  = "LOW_MORALE"
+	FLEET_EVENT_WAGE_BURN   = "WAGE_BURN"
+	FLEET_EVENT_ERROR       = "ERROR"
+)
+
+// Morale runs from 0 to 100.
+const DEFAULT_LOW_MORALE = 40
+
+type FleetEvent struct {
+	Type       string
+	ShipSymbol string
+	Time       time.Time
+	Message    string
+	Err        error
+}
+
+func (self FleetEvent) String() string {
+	if self.ShipSymbol == "" {
+		return fmt.Sprintf("[%s] %s", self.Type, self.Message)
+	}
+
+	return fmt.Sprintf("[%s] %s: %s", self.Type, self.ShipSymbol, self.Message)
+}
+
+// Credits per hour the whole fleet costs in wages.
+func FleetWageBurn(ships []Ship) (creditsPerHour int) {
+	for _, ship := range ships {
+		if ship.Crew == nil {
+			continue
+		}
+
+		creditsPerHour += ship.Crew.Current * ship.Crew.Wages
+	}
+
+	return creditsPerHour
+}
+
+// Events for ships with less crew than they need or morale below lowMorale.
+func CrewAlerts(ships []Ship, lowMorale int) (events []FleetEvent) {
+	now := time.Now()
+
+	for _, ship := range ships {
+		if ship.Crew == nil {
+			continue
+		}
+
+		if ship.Crew.Current < ship.Crew.Required {
+			events = append(events, FleetEvent{
+				Type:       FLEET_EVENT_UNDERCREWED,
+				ShipSymbol: ship.Symbol,
+				Time:       now,
+				Message: fmt.Sprintf(
+					"crew %d of %d required",
+					ship.Crew.Current,
+					ship.Crew.Required,
+				),
+			})
+		}
+
+		if ship.Crew.Morale < lowMorale {
+			events = append(events, FleetEvent{
+				Type:       FLEET_EVENT_LOW_MORALE,
+				ShipSymbol: ship.Symbol,
+				Time:       now,
+				Message: fmt.Sprintf(
+					"morale %d is below %d",
+					ship.Crew.Morale,
+					lowMorale,
+				),
+			})
+		}
+	}
+
+	return events
+}
+
+// Polls the agent's ships and sends FleetEvents on Events.
+// A crew alert is only sent when a ship starts having the problem, not on every poll.
+// The wage burn is sent whenever it changes.
+type FleetMonitor struct {
+	Token     string
+	Interval  time.Duration
+	LowMorale int
+	Events    chan FleetEvent
+
+	active   map[string]bool
+	wageBurn int
+}
+
+func NewFleetMonitor(token string, interval time.Duration) *FleetMonitor {
+	return &FleetMonitor{
+		Token:     token,
+		Interval:  interval,
+		LowMorale: DEFAULT_LOW_MORALE,
+		Events:    make(chan FleetEvent, 64),
+		active:    make(map[string]bool),
+		wageBurn:  -1,
+	}
+}
+
+// Works out which events are new since the last call.
+func (self *FleetMonitor) check(ships []Ship) (events []FleetEvent) {
+	if self.active == nil {
+		self.active = make(map[string]bool)
+	}
+	stillActive := make(map[string]bool)
+
+	for _, event := range CrewAlerts(ships, self.LowMorale) {
+		key := event.Type + " " + event.ShipSymbol
+		stillActive[key] = true
+
+		if !self.active[key] {
+			events = append(events, event)
+		}
+	}
+	self.active = stillActive
+
+	burn := FleetWageBurn(ships)
+	if burn != self.wageBurn {
+		self.wageBurn = burn
+		events = append(events, FleetEvent{
+			Type:    FLEET_EVENT_WAGE_BURN,
+			Time:    time.Now(),
+			Message: fmt.Sprintf("%dc/h across %d ships", burn, len(ships)),
+		})
+	}
+
+	return events
+}
+
+// Polls every Interval (default 1 minute) until ctx is done, then closes Events.
+func (self *FleetMonitor) Run(ctx context.Context) {
+	defer close(self.Events)
+
+	interval := self.Interval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		ships, err := GetShipsByAgent(self.Token)
+
+		events := []FleetEvent{}
+		if err != nil {
+			events = append(events, FleetEvent{
+				Type:    FLEET_EVENT_ERROR,
+				Time:    time.Now(),
+				Message: err.Error(),
+				Err:     err,
+			})
+		} else {
+			events = self.check(ships)
+		}
+
+		for _, event := range events {
+			select {
+			case self.Events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,445 @@
+package space_traders_api
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/brendoncdodd/space_traders_api/api"
+)
+
+// One good's prices at one market at one time.
+type PriceRecord struct {
+	Timestamp      time.Time
+	WaypointSymbol string
+	TradeSymbol    TradeSymbol
+	Type           TradeGoodType
+	Supply         SupplyLevel
+	Activity       ActivityLevel
+	TradeVolume    int
+	PurchasePrice  int
+	SellPrice      int
+}
+
+// Which records a query looks at. Empty fields match everything,
+// so the zero value is every record.
+type PriceQuery struct {
+	WaypointSymbol string
+	TradeSymbol    TradeSymbol
+	// Records from this time on.
+	From time.Time
+	// Records before this time.
+	To time.Time
+}
+
+func (self *PriceQuery) matches(record *PriceRecord) bool {
+	return (self.WaypointSymbol == "" || self.WaypointSymbol == record.WaypointSymbol) &&
+		(self.TradeSymbol == "" || self.TradeSymbol == record.TradeSymbol) &&
+		(self.From.IsZero() || !record.Timestamp.Before(self.From)) &&
+		(self.To.IsZero() || record.Timestamp.Before(self.To))
+}
+
+// Min, max and average prices over the records a query matched.
+// Everything is 0 if Count is.
+type PriceStats struct {
+	Count         int
+	First         time.Time
+	Last          time.Time
+	MinPurchase   int
+	MaxPurchase   int
+	AvgPurchase   float64
+	MinSell       int
+	MaxSell       int
+	AvgSell       float64
+	FirstPurchase int
+	LastPurchase  int
+	FirstSell     int
+	LastSell      int
+}
+
+// Change in purchase price from the first record to the last, as a fraction of the first.
+func (self PriceStats) PurchaseTrend() float64 {
+	if self.FirstPurchase == 0 {
+		return 0
+	}
+
+	return float64(self.LastPurchase-self.FirstPurchase) / float64(self.FirstPurchase)
+}
+
+// Change in sell price from the first record to the last, as a fraction of the first.
+func (self PriceStats) SellTrend() float64 {
+	if self.FirstSell == 0 {
+		return 0
+	}
+
+	return float64(self.LastSell-self.FirstSell) / float64(self.FirstSell)
+}
+
+// A PriceHistory kept in a file, one JSON PriceRecord per line.
+// The file is only ever appended to. Everything in it is also kept in memory for queries.
+type FilePriceHistory struct {
+	Filename string
+
+	mutex   sync.Mutex
+	records []PriceRecord
+	// Index into records of the latest record for each waypoint and good.
+	latest map[string]map[TradeSymbol]int
+}
+
+// Loads the history in filename if it exists. The file is created on the first Record.
+// A last line that doesn't decode was torn by a crash mid-write. It's dropped,
+// and cut from the file so the next Record doesn't append to it.
+// Bad lines anywhere else are an error.
+func NewFilePriceHistory(filename string) (*FilePriceHistory, error) {
+	errPrefix := "Loading price history."
+	history := &FilePriceHistory{
+		Filename: filename,
+		latest:   make(map[string]map[TradeSymbol]int),
+	}
+
+	file, err := os.Open(filename)
+	if os.IsNotExist(err) {
+		return history, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s Opening %s %w", errPrefix, filename, err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	line := 0
+	offset := int64(0)
+	// The first line that didn't decode and where it starts. Only fine if nothing comes after it.
+	badLine := 0
+	badOffset := int64(0)
+	var badErr error
+	for {
+		buf, readErr := reader.ReadBytes('\n')
+		if readErr != nil && readErr != io.EOF {
+			return nil, fmt.Errorf("%s Reading %s %w", errPrefix, filename, readErr)
+		}
+		start := offset
+		offset += int64(len(buf))
+		if len(buf) > 0 {
+			line++
+		}
+
+		if trimmed := bytes.TrimSpace(buf); len(trimmed) > 0 {
+			if badErr != nil {
+				return nil, fmt.Errorf("%s Decoding line %d of %s %w", errPrefix, badLine, filename, badErr)
+			}
+
+			record := PriceRecord{}
+			err = json.Unmarshal(trimmed, &record)
+			if err != nil {
+				badLine, badOffset, badErr = line, start, err
+			} else {
+				history.add(record)
+			}
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+	}
+
+	if badErr != nil {
+		err = os.Truncate(filename, badOffset)
+		if err != nil {
+			return nil, fmt.Errorf("%s Cutting torn line %d from %s %w", errPrefix, badLine, filename, err)
+		}
+	}
+
+	return history, nil
+}
+
+// Must be called with the mutex held.
+func (self *FilePriceHistory) add(record PriceRecord) {
+	if self.latest == nil {
+		self.latest = make(map[string]map[TradeSymbol]int)
+	}
+	goods, ok := self.latest[record.WaypointSymbol]
+	if !ok {
+		goods = make(map[TradeSymbol]int)
+		self.latest[record.WaypointSymbol] = goods
+	}
+
+	self.records = append(self.records, record)
+	index, ok := goods[record.TradeSymbol]
+	if !ok || !record.Timestamp.Before(self.records[index].Timestamp) {
+		goods[record.TradeSymbol] = len(self.records) - 1
+	}
+}
+
+// Appends a record for every good the market has prices for.
+// Markets seen without a ship there have no prices and add nothing.
+func (self *FilePriceHistory) Record(market Market) error {
+	errPrefix := "Recording prices at " + market.Symbol + "."
+
+	timestamp := market.ObservedAt
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	records := []PriceRecord{}
+	for _, good := range market.TradeGoods {
+		records = append(records, PriceRecord{
+			Timestamp:      timestamp,
+			WaypointSymbol: market.Symbol,
+			TradeSymbol:    good.Symbol,
+			Type:           good.Type,
+			Supply:         good.Supply,
+			Activity:       good.Activity,
+			TradeVolume:    good.TradeVolume,
+			PurchasePrice:  good.PurchasePrice,
+			SellPrice:      good.SellPrice,
+		})
+	}
+	if len(records) == 0 {
+		return nil
+	}
+
+	lines := []byte{}
+	for _, record := range records {
+		line, err := json.Marshal(record)
+		if err != nil {
+			return fmt.Errorf("%s Encoding JSON. %w", errPrefix, err)
+		}
+		lines = append(append(lines, line...), '\n')
+	}
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	file, err := os.OpenFile(self.Filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("%s Opening %s %w", errPrefix, self.Filename, err)
+	}
+	_, err = file.Write(lines)
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("%s Writing %s %w", errPrefix, self.Filename, err)
+	}
+	err = file.Close()
+	if err != nil {
+		return fmt.Errorf("%s Closing %s %w", errPrefix, self.Filename, err)
+	}
+
+	for _, record := range records {
+		self.add(record)
+	}
+
+	return nil
+}
+
+// The most recent record of a good at a market.
+func (self *FilePriceHistory) Latest(waypointSymbol string, tradeSymbol TradeSymbol) (PriceRecord, bool) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	index, ok := self.latest[waypointSymbol][tradeSymbol]
+	if !ok {
+		return PriceRecord{}, false
+	}
+
+	return self.records[index], true
+}
+
+// When each market was last recorded. Useful to seed ProbeScheduler.Observed at startup.
+func (self *FilePriceHistory) LastObserved() map[string]time.Time {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	observed := make(map[string]time.Time)
+	for waypointSymbol, goods := range self.latest {
+		for _, index := range goods {
+			if at := self.records[index].Timestamp; at.After(observed[waypointSymbol]) {
+				observed[waypointSymbol] = at
+			}
+		}
+	}
+
+	return observed
+}
+
+// Every market's latest prices, rebuilt as Markets for FindTradeRoutes and friends.
+// Pass an empty systemSymbol for every system.
+// Only the goods with prices are filled in, and ObservedAt is the oldest of them.
+func (self *FilePriceHistory) LatestMarkets(systemSymbol string) []Market {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	markets := []Market{}
+	for waypointSymbol, goods := range self.latest {
+		if systemSymbol != "" {
+			symbol, err := ParseWaypointSymbol(waypointSymbol)
+			if err != nil || symbol.SystemSymbol() != systemSymbol {
+				continue
+			}
+		}
+
+		market := Market{Market: api.Market{Symbol: waypointSymbol}}
+		for _, index := range goods {
+			record := &self.records[index]
+			market.TradeGoods = append(market.TradeGoods, MarketTradeGood{
+				Symbol:        record.TradeSymbol,
+				Type:          record.Type,
+				TradeVolume:   record.TradeVolume,
+				Supply:        record.Supply,
+				Activity:      record.Activity,
+				PurchasePrice: record.PurchasePrice,
+				SellPrice:     record.SellPrice,
+			})
+			if market.ObservedAt.IsZero() || record.Timestamp.Before(market.ObservedAt) {
+				market.ObservedAt = record.Timestamp
+			}
+		}
+		sort.Slice(market.TradeGoods, func(i, j int) bool {
+			return market.TradeGoods[i].Symbol < market.TradeGoods[j].Symbol
+		})
+		markets = append(markets, market)
+	}
+	sort.Slice(markets, func(i, j int) bool { return markets[i].Symbol < markets[j].Symbol })
+
+	return markets
+}
+
+// Gives markets that came without prices, because we had no ship there,
+// the latest prices recorded for them. Markets with prices are left alone.
+func (self *FilePriceHistory) fillPrices(markets []Market) {
+	recorded := make(map[string]Market)
+	for _, market := range self.LatestMarkets("") {
+		recorded[market.Symbol] = market
+	}
+
+	for i := range markets {
+		market, ok := recorded[markets[i].Symbol]
+		if !ok || len(markets[i].TradeGoods) > 0 {
+			continue
+		}
+		markets[i].TradeGoods = market.TradeGoods
+		markets[i].ObservedAt = market.ObservedAt
+	}
+}
+
+// The records a query matches, oldest first.
+func (self *FilePriceHistory) Prices(query PriceQuery) []PriceRecord {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	records := []PriceRecord{}
+	for i := range self.records {
+		if query.matches(&self.records[i]) {
+			records = append(records, self.records[i])
+		}
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Timestamp.Before(records[j].Timestamp)
+	})
+
+	return records
+}
+
+// Min, max and average prices over the records a query matches.
+// Mixing goods in one query mixes their prices, so usually set TradeSymbol.
+func (self *FilePriceHistory) Stats(query PriceQuery) PriceStats {
+	records := self.Prices(query)
+	stats := PriceStats{Count: len(records)}
+	if len(records) == 0 {
+		return stats
+	}
+
+	first := records[0]
+	last := records[len(records)-1]
+	stats.First = first.Timestamp
+	stats.Last = last.Timestamp
+	stats.FirstPurchase = first.PurchasePrice
+	stats.FirstSell = first.SellPrice
+	stats.LastPurchase = last.PurchasePrice
+	stats.LastSell = last.SellPrice
+	stats.MinPurchase = math.MaxInt
+	stats.MinSell = math.MaxInt
+
+	purchaseTotal := 0
+	sellTotal := 0
+	for _, record := range records {
+		stats.MinPurchase = min(stats.MinPurchase, record.PurchasePrice)
+		stats.MaxPurchase = max(stats.MaxPurchase, record.PurchasePrice)
+		stats.MinSell = min(stats.MinSell, record.SellPrice)
+		stats.MaxSell = max(stats.MaxSell, record.SellPrice)
+		purchaseTotal += record.PurchasePrice
+		sellTotal += record.SellPrice
+	}
+	stats.AvgPurchase = float64(purchaseTotal) / float64(len(records))
+	stats.AvgSell = float64(sellTotal) / float64(len(records))
+
+	return stats
+}
+
+// Writes the records a query matches as a JSON array.
+func (self *FilePriceHistory) ExportJSON(w io.Writer, query PriceQuery) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "\t")
+
+	err := encoder.Encode(self.Prices(query))
+	if err != nil {
+		return fmt.Errorf("Exporting prices as JSON. %w", err)
+	}
+
+	return nil
+}
+
+// Writes the records a query matches as CSV with a header row.
+// Timestamps are RFC 3339.
+func (self *FilePriceHistory) ExportCSV(w io.Writer, query PriceQuery) error {
+	errPrefix := "Exporting prices as CSV."
+	writer := csv.NewWriter(w)
+
+	err := writer.Write([]string{
+		"timestamp",
+		"waypoint",
+		"trade_symbol",
+		"type",
+		"supply",
+		"activity",
+		"trade_volume",
+		"purchase_price",
+		"sell_price",
+	})
+	if err != nil {
+		return fmt.Errorf("%s Writing header. %w", errPrefix, err)
+	}
+
+	for _, record := range self.Prices(query) {
+		err = writer.Write([]string{
+			record.Timestamp.Format(time.RFC3339),
+			record.WaypointSymbol,
+			string(record.TradeSymbol),
+			string(record.Type),
+			string(record.Supply),
+			string(record.Activity),
+			strconv.Itoa(record.TradeVolume),
+			strconv.Itoa(record.PurchasePrice),
+			strconv.Itoa(record.SellPrice),
+		})
+		if err != nil {
+			return fmt.Errorf("%s Writing record. %w", errPrefix, err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("%s %w", errPrefix, err)
+	}
+
+	return nil
+}
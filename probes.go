@@ -0,0 +1,479 @@
+package space_traders_api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// How often a market is looked at, unless ProbeScheduler.Interval says otherwise.
+const DEFAULT_PROBE_INTERVAL = 15 * time.Minute
+
+// A probe's claim on a market it's going to is dropped after this,
+// in case the probe was stopped on the way.
+const PROBE_CLAIM_TIMEOUT = time.Hour
+
+// Where market snapshots from probes are kept. FilePriceHistory is one.
+type PriceHistory interface {
+	Record(market Market) error
+}
+
+type probeClaim struct {
+	probe string
+	at    time.Time
+}
+
+// Keeps market snapshots fresh with probes.
+// Each probe either parks at a market of its own and looks at it every Interval,
+// or tours the markets that are due, stalest and most valuable first, in nearest neighbour order.
+// Probes share one scheduler so they don't go to the same market.
+type ProbeScheduler struct {
+	// Waypoints to watch. If empty, every marketplace in a probe's system is.
+	Markets []string
+	// How old a snapshot gets before it's due again. Default DEFAULT_PROBE_INTERVAL.
+	Interval time.Duration
+	// Park probes at a market each instead of touring.
+	// Probes that find every market taken tour instead.
+	Park bool
+	// Longest tour a probe is sent on before planning again. 0 for every market that's due.
+	MaxTour int
+	// Snapshots are recorded here. Can be nil.
+	History PriceHistory
+	// How much a market matters beyond its age, such as how many trade routes use it.
+	// A market's priority is its age times 1 + Value. Can be nil.
+	Value func(waypointSymbol string) float64
+	// Defaults to GetWaypointLocation.
+	Location func(waypointSymbol string) (Vector2, error)
+	// Called with every snapshot. Can be nil.
+	OnObserve func(market *Market)
+
+	mutex    sync.Mutex
+	observed map[string]time.Time
+	claims   map[string]probeClaim
+	parked   map[string]string
+	loaded   map[string]bool
+}
+
+func NewProbeScheduler(history PriceHistory) *ProbeScheduler {
+	return &ProbeScheduler{
+		History:  history,
+		observed: make(map[string]time.Time),
+		claims:   make(map[string]probeClaim),
+		parked:   make(map[string]string),
+		loaded:   make(map[string]bool),
+	}
+}
+
+func (self *ProbeScheduler) init() {
+	if self.observed == nil {
+		self.observed = make(map[string]time.Time)
+	}
+	if self.claims == nil {
+		self.claims = make(map[string]probeClaim)
+	}
+	if self.parked == nil {
+		self.parked = make(map[string]string)
+	}
+	if self.loaded == nil {
+		self.loaded = make(map[string]bool)
+	}
+}
+
+func (self *ProbeScheduler) interval() time.Duration {
+	if self.Interval <= 0 {
+		return DEFAULT_PROBE_INTERVAL
+	}
+
+	return self.Interval
+}
+
+func (self *ProbeScheduler) location(waypointSymbol string) (Vector2, error) {
+	if self.Location != nil {
+		return self.Location(waypointSymbol)
+	}
+
+	return GetWaypointLocation(waypointSymbol)
+}
+
+// Tells the scheduler a market was seen at some time, such as from a snapshot
+// loaded at startup, so it isn't treated as never seen.
+func (self *ProbeScheduler) Observed(waypointSymbol string, at time.Time) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.init()
+	if at.After(self.observed[waypointSymbol]) {
+		self.observed[waypointSymbol] = at
+	}
+}
+
+// When each market was last seen. Markets never seen aren't included.
+func (self *ProbeScheduler) LastObserved() map[string]time.Time {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	observed := make(map[string]time.Time)
+	for symbol, at := range self.observed {
+		observed[symbol] = at
+	}
+
+	return observed
+}
+
+// Adds the marketplaces in a system to Markets, once per system.
+// Does nothing if Markets was given up front.
+// Probes in the same system may both get the waypoints, but markets are only added once.
+func (self *ProbeScheduler) loadMarkets(systemSymbol string) error {
+	self.mutex.Lock()
+	self.init()
+	skip := self.loaded[systemSymbol] || (len(self.Markets) > 0 && len(self.loaded) == 0)
+	self.mutex.Unlock()
+	if skip {
+		return nil
+	}
+
+	waypoints, err := GetAllWaypointsInSystem(systemSymbol)
+	if err != nil {
+		return err
+	}
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if self.loaded[systemSymbol] {
+		return nil
+	}
+	self.loaded[systemSymbol] = true
+
+	known := make(map[string]bool)
+	for _, market := range self.Markets {
+		known[market] = true
+	}
+	for _, waypoint := range waypoints {
+		if waypoint.HasTrait(WAYPOINT_TRAIT_MARKETPLACE) && !known[waypoint.Symbol] {
+			known[waypoint.Symbol] = true
+			self.Markets = append(self.Markets, waypoint.Symbol)
+		}
+	}
+
+	return nil
+}
+
+// Markets in the system. Must be called with the mutex held.
+func (self *ProbeScheduler) marketsIn(systemSymbol string) []string {
+	markets := []string{}
+	for _, market := range self.Markets {
+		symbol, err := ParseWaypointSymbol(market)
+		if err != nil || symbol.SystemSymbol() != systemSymbol {
+			continue
+		}
+		markets = append(markets, market)
+	}
+
+	return markets
+}
+
+// Whether someone other than probe has a live claim on a market.
+// Must be called with the mutex held.
+func (self *ProbeScheduler) claimedByOther(market string, probe string, now time.Time) bool {
+	claim, ok := self.claims[market]
+
+	return ok && claim.probe != probe && now.Sub(claim.at) < PROBE_CLAIM_TIMEOUT
+}
+
+// Must be called with the mutex held.
+func (self *ProbeScheduler) releaseClaims(probe string) {
+	for market, claim := range self.claims {
+		if claim.probe == probe && self.parked[probe] != market {
+			delete(self.claims, market)
+		}
+	}
+}
+
+// How long since a market was seen. Markets never seen count as a hundred intervals old.
+// Must be called with the mutex held.
+func (self *ProbeScheduler) age(market string, now time.Time) time.Duration {
+	if observed, ok := self.observed[market]; ok {
+		return now.Sub(observed)
+	}
+
+	return 100 * self.interval()
+}
+
+// Age times 1 + Value. Value can be slow, so call this without the mutex.
+func (self *ProbeScheduler) priority(market string, age time.Duration) float64 {
+	value := 0.0
+	if self.Value != nil {
+		value = self.Value(market)
+	}
+
+	return age.Hours() * (1 + value)
+}
+
+// Picks the closest market no other probe is parked at and parks probe there.
+// Empty if every market is taken.
+// Locations can make requests, so they're found without the mutex
+// and the claims checked again before parking.
+func (self *ProbeScheduler) parkAt(probe string, systemSymbol string, from string, now time.Time) string {
+	self.mutex.Lock()
+	self.init()
+	if market, ok := self.parked[probe]; ok {
+		self.mutex.Unlock()
+		return market
+	}
+	candidates := []string{}
+	for _, market := range self.marketsIn(systemSymbol) {
+		if !self.claimedByOther(market, probe, now) {
+			candidates = append(candidates, market)
+		}
+	}
+	self.mutex.Unlock()
+
+	origin, err := self.location(from)
+	if err != nil {
+		return ""
+	}
+
+	distances := make(map[string]float64)
+	nearest := []string{}
+	for _, market := range candidates {
+		location, err := self.location(market)
+		if err != nil {
+			continue
+		}
+		distances[market] = origin.Distance(location)
+		nearest = append(nearest, market)
+	}
+	sort.Slice(nearest, func(i, j int) bool {
+		if distances[nearest[i]] != distances[nearest[j]] {
+			return distances[nearest[i]] < distances[nearest[j]]
+		}
+		return nearest[i] < nearest[j]
+	})
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if market, ok := self.parked[probe]; ok {
+		return market
+	}
+	for _, market := range nearest {
+		if self.claimedByOther(market, probe, now) {
+			continue
+		}
+
+		self.releaseClaims(probe)
+		self.parked[probe] = market
+		self.claims[market] = probeClaim{probe, now}
+		return market
+	}
+
+	return ""
+}
+
+// Works out the next tour for a probe and claims its markets.
+// Markets are due once they're Interval old. The ones with the highest priority are picked,
+// then visited in nearest neighbour order from where the probe is.
+// Priorities and locations are worked out without the mutex,
+// and markets another probe claimed in the meantime are left out.
+func (self *ProbeScheduler) planTour(probe string, systemSymbol string, from string, now time.Time) []string {
+	self.mutex.Lock()
+	self.init()
+	self.releaseClaims(probe)
+
+	due := []string{}
+	ages := make(map[string]time.Duration)
+	for _, market := range self.marketsIn(systemSymbol) {
+		if self.claimedByOther(market, probe, now) {
+			continue
+		}
+		if observed, ok := self.observed[market]; ok && now.Sub(observed) < self.interval() {
+			continue
+		}
+		due = append(due, market)
+		ages[market] = self.age(market, now)
+	}
+	self.mutex.Unlock()
+
+	priorities := make(map[string]float64)
+	for _, market := range due {
+		priorities[market] = self.priority(market, ages[market])
+	}
+	sort.SliceStable(due, func(i, j int) bool {
+		if priorities[due[i]] != priorities[due[j]] {
+			return priorities[due[i]] > priorities[due[j]]
+		}
+		return due[i] < due[j]
+	})
+	if self.MaxTour > 0 && len(due) > self.MaxTour {
+		due = due[:self.MaxTour]
+	}
+
+	start, err := self.location(from)
+	if err != nil && len(due) > 0 {
+		start, _ = self.location(due[0])
+	}
+	locations := make(map[string]Vector2)
+	for _, market := range due {
+		location, err := self.location(market)
+		if err != nil {
+			continue
+		}
+		locations[market] = location
+	}
+
+	self.mutex.Lock()
+	for market := range locations {
+		if self.claimedByOther(market, probe, now) {
+			delete(locations, market)
+			continue
+		}
+		self.claims[market] = probeClaim{probe, now}
+	}
+	self.mutex.Unlock()
+
+	return nearestNeighbourTour(start, locations)
+}
+
+// When the next market in a system that nobody else has claimed is due.
+func (self *ProbeScheduler) nextDue(probe string, systemSymbol string, now time.Time) time.Time {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	next := now.Add(self.interval())
+	for _, market := range self.marketsIn(systemSymbol) {
+		if self.claimedByOther(market, probe, now) {
+			continue
+		}
+		observed, ok := self.observed[market]
+		if !ok {
+			return now
+		}
+		if due := observed.Add(self.interval()); due.Before(next) {
+			next = due
+		}
+	}
+
+	return next
+}
+
+// Gets the market where the ship is, records it and releases the ship's claim on it.
+func (self *ProbeScheduler) observe(ship *Ship, token string) (*Market, error) {
+	market, err := GetMarket(ship.Nav.WaypointSymbol, token)
+	if err != nil {
+		return nil, err
+	}
+
+	self.mutex.Lock()
+	self.init()
+	self.observed[market.Symbol] = market.ObservedAt
+	if self.parked[ship.Symbol] == market.Symbol {
+		self.claims[market.Symbol] = probeClaim{ship.Symbol, market.ObservedAt}
+	} else if claim, ok := self.claims[market.Symbol]; ok && claim.probe == ship.Symbol {
+		delete(self.claims, market.Symbol)
+	}
+	self.mutex.Unlock()
+
+	if self.OnObserve != nil {
+		self.OnObserve(market)
+	}
+	if self.History != nil {
+		err = self.History.Record(*market)
+		if err != nil {
+			return market, fmt.Errorf("Recording market %s. %w", market.Symbol, err)
+		}
+	}
+
+	return market, nil
+}
+
+// A Behavior for one probe. Every probe needs its own.
+func (self *ProbeScheduler) Behavior() Behavior {
+	return &probeBehavior{scheduler: self}
+}
+
+// A repeating coordinator job that puts every satellite on probe duty.
+func (self *ProbeScheduler) Job(id string, priority int) Job {
+	return Job{
+		ID:       id,
+		Type:     JOB_PROBE,
+		Roles:    []ShipRole{SHIP_ROLE_SATELLITE},
+		Slots:    math.MaxInt32,
+		Priority: priority,
+		Repeat:   true,
+		Behavior: func(ship *Ship) Behavior { return self.Behavior() },
+	}
+}
+
+type probeBehavior struct {
+	scheduler *ProbeScheduler
+	tour      []string
+}
+
+func (self *probeBehavior) Step(ctx context.Context, ship *Ship, token string) (BehaviorStep, error) {
+	errPrefix := "Probing markets with " + ship.Symbol + "."
+	scheduler := self.scheduler
+
+	if ship.Nav == nil {
+		return BehaviorStep{}, fmt.Errorf("%s Ship has no nav.", errPrefix)
+	}
+	systemSymbol := ship.Nav.SystemSymbol
+	now := time.Now()
+
+	err := scheduler.loadMarkets(systemSymbol)
+	if err != nil {
+		return BehaviorStep{}, fmt.Errorf("%s Loading markets.\n%w", errPrefix, err)
+	}
+
+	if scheduler.Park {
+		if market := scheduler.parkAt(ship.Symbol, systemSymbol, ship.Nav.WaypointSymbol, now); market != "" {
+			arrived, step, err := moveTo(ship, market, token)
+			if err != nil {
+				return step, fmt.Errorf("%s %w", errPrefix, err)
+			}
+			if !arrived {
+				return step, nil
+			}
+
+			_, err = scheduler.observe(ship, token)
+			if err != nil {
+				return BehaviorStep{}, fmt.Errorf("%s %w", errPrefix, err)
+			}
+
+			return BehaviorStep{
+				Action: "looked at " + market,
+				WakeAt: time.Now().Add(scheduler.interval()),
+			}, nil
+		}
+	}
+
+	if len(self.tour) == 0 {
+		self.tour = scheduler.planTour(ship.Symbol, systemSymbol, ship.Nav.WaypointSymbol, now)
+		if len(self.tour) == 0 {
+			return BehaviorStep{
+				Action: "no markets due",
+				WakeAt: scheduler.nextDue(ship.Symbol, systemSymbol, now),
+			}, nil
+		}
+	}
+
+	market := self.tour[0]
+	arrived, step, err := moveTo(ship, market, token)
+	if err != nil {
+		return step, fmt.Errorf("%s %w", errPrefix, err)
+	}
+	if !arrived {
+		return step, nil
+	}
+
+	_, err = scheduler.observe(ship, token)
+	if err != nil {
+		return BehaviorStep{}, fmt.Errorf("%s %w", errPrefix, err)
+	}
+	self.tour = self.tour[1:]
+
+	return BehaviorStep{Action: fmt.Sprintf("looked at %s, %d to go", market, len(self.tour))}, nil
+}
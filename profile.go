@@ -0,0 +1,149 @@
+package space_traders_api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// What we need to keep about an agent between runs.
+// ResetDate is the server reset the token was issued for.
+// Stale is set once the server has reset and the token stopped working.
+type Profile struct {
+	Symbol    string
+	Faction   string
+	Token     string
+	ResetDate string
+	Stale     bool
+}
+
+// Makes a profile from an agent token.
+// The symbol and reset date come from the token's claims.
+func NewProfile(token string, faction string) (*Profile, error) {
+	claims, err := RequireAgentToken(token)
+	if err != nil {
+		return nil, fmt.Errorf("Creating profile. %w", err)
+	}
+
+	return &Profile{
+		Symbol:    claims.Identifier,
+		Faction:   faction,
+		Token:     token,
+		ResetDate: claims.ResetDate,
+	}, nil
+}
+
+type ProfileStore interface {
+	LoadProfile(symbol string) (*Profile, error)
+	SaveProfile(profile *Profile) error
+	ListProfiles() ([]*Profile, error)
+}
+
+// Keeps each profile in its own JSON file, [Dir]/[symbol].json
+type FileProfileStore struct {
+	Dir string
+}
+
+// Creates dir if it doesn't exist.
+func NewFileProfileStore(dir string) (*FileProfileStore, error) {
+	err := os.MkdirAll(dir, fs.ModePerm)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"Creating profile store. Making directory %s %w",
+			dir,
+			err,
+		)
+	}
+
+	return &FileProfileStore{Dir: dir}, nil
+}
+
+func (self *FileProfileStore) filename(symbol string) string {
+	return filepath.Join(self.Dir, symbol+".json")
+}
+
+func (self *FileProfileStore) LoadProfile(symbol string) (*Profile, error) {
+	errPrefix := "Loading profile " + symbol + "."
+	profile := new(Profile)
+
+	fileData, err := os.ReadFile(self.filename(symbol))
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Reading profile file. %w",
+			errPrefix,
+			err,
+		)
+	}
+
+	err = json.Unmarshal(fileData, profile)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Decoding JSON. %w",
+			errPrefix,
+			err,
+		)
+	}
+
+	return profile, nil
+}
+
+func (self *FileProfileStore) SaveProfile(profile *Profile) error {
+	errPrefix := "Saving profile " + profile.Symbol + "."
+
+	if profile.Symbol == "" {
+		return fmt.Errorf("%s Profile has no symbol.", errPrefix)
+	}
+
+	fileData, err := json.MarshalIndent(profile, "", "\t")
+	if err != nil {
+		return fmt.Errorf(
+			"%s Encoding JSON. %w",
+			errPrefix,
+			err,
+		)
+	}
+
+	err = os.WriteFile(self.filename(profile.Symbol), fileData, 0600)
+	if err != nil {
+		return fmt.Errorf(
+			"%s Writing profile file. %w",
+			errPrefix,
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (self *FileProfileStore) ListProfiles() ([]*Profile, error) {
+	errPrefix := "Listing profiles."
+	profiles := []*Profile{}
+
+	entries, err := os.ReadDir(self.Dir)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Reading directory %s %w",
+			errPrefix,
+			self.Dir,
+			err,
+		)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+
+		profile, err := self.LoadProfile(strings.TrimSuffix(entry.Name(), ".json"))
+		if err != nil {
+			return profiles, fmt.Errorf("%s %w", errPrefix, err)
+		}
+
+		profiles = append(profiles, profile)
+	}
+
+	return profiles, nil
+}
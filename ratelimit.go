@@ -0,0 +1,159 @@
+package space_traders_api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// spacetraders.io allows 2 requests a second per account, plus a burst of 30 over a minute.
+// The limiter doesn't keep a separate burst pool: it's one bucket refilling at the
+// steady rate, so a used-up burst is back after 15 seconds idle.
+// Any 429s that causes are retried by doRateLimited.
+const (
+	RATE_LIMIT_PER_SECOND  = 2
+	RATE_LIMIT_BURST       = 30
+	MAX_RATE_LIMIT_RETRIES = 3
+)
+
+// A token bucket. Wait blocks until a request can be sent.
+// One is shared by every request this package makes,
+// so any number of goroutines can send requests without getting 429s.
+// Rate <= 0 means no limit. Burst is at least 1, or nothing could ever be sent.
+type RateLimiter struct {
+	Rate  float64
+	Burst int
+
+	mutex  sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	burst = max(burst, 1)
+
+	return &RateLimiter{
+		Rate:   rate,
+		Burst:  burst,
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Takes a token, waiting for one if there are none.
+// Returns ctx's error if it's done first.
+func (self *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		wait := self.reserve()
+		if wait <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Takes a token if there is one, otherwise says how long until there will be.
+func (self *RateLimiter) reserve() time.Duration {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if self.Rate <= 0 {
+		return 0
+	}
+
+	burst := float64(max(self.Burst, 1))
+	now := time.Now()
+	self.tokens += now.Sub(self.last).Seconds() * self.Rate
+	if self.tokens > burst {
+		self.tokens = burst
+	}
+	self.last = now
+
+	if self.tokens >= 1 {
+		self.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - self.tokens) / self.Rate * float64(time.Second))
+}
+
+var (
+	rateLimiter      = NewRateLimiter(RATE_LIMIT_PER_SECOND, RATE_LIMIT_BURST)
+	rateLimiterMutex sync.Mutex
+)
+
+// Replaces the limiter used by SendRequest. Pass nil to turn limiting off.
+func SetRateLimiter(limiter *RateLimiter) {
+	rateLimiterMutex.Lock()
+	defer rateLimiterMutex.Unlock()
+
+	rateLimiter = limiter
+}
+
+func activeRateLimiter() *RateLimiter {
+	rateLimiterMutex.Lock()
+	defer rateLimiterMutex.Unlock()
+
+	return rateLimiter
+}
+
+// Sends req once the rate limiter allows it.
+// A 429 is retried after its Retry-After, up to MAX_RATE_LIMIT_RETRIES times,
+// as long as the body can be sent again.
+func doRateLimited(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if limiter := activeRateLimiter(); limiter != nil {
+			err := limiter.Wait(req.Context())
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		canResend := req.GetBody != nil || req.Method == "GET"
+		if resp.StatusCode != http.StatusTooManyRequests ||
+			attempt >= MAX_RATE_LIMIT_RETRIES ||
+			!canResend {
+			return resp, nil
+		}
+		resp.Body.Close()
+
+		timer := time.NewTimer(retryAfter(resp))
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// Retry-After is in seconds, and can be fractional.
+func retryAfter(resp *http.Response) time.Duration {
+	seconds, err := strconv.ParseFloat(resp.Header.Get("Retry-After"), 64)
+	if err != nil || seconds <= 0 {
+		return time.Second
+	}
+
+	return time.Duration(seconds * float64(time.Second))
+}
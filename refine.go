@@ -0,0 +1,102 @@
+package space_traders_api
+
+import (
+	"fmt"
+)
+
+type RefineYield struct {
+	TradeSymbol TradeSymbol
+	Units       int
+}
+
+type RefineResult struct {
+	Cargo    *ShipCargo
+	Cooldown *ShipCooldown
+	Produced []RefineYield
+	Consumed []RefineYield
+}
+
+// What a refinery module can turn into what.
+// InputUnits is how much the server takes for one refine.
+type RefineRecipe struct {
+	Produce    TradeSymbol
+	Input      TradeSymbol
+	InputUnits int
+	Modules    []ShipModuleSymbol
+}
+
+var oreRefineries = []ShipModuleSymbol{MODULE_ORE_REFINERY_I, MODULE_MICRO_REFINERY_I}
+
+// The Produce symbols are the produce enum of the ship-refine operation in the API docs,
+// and the ore or hydrocarbon each one is made from.
+// The docs don't say how much input a refine takes. 30 units in for 10 out is what players
+// report for v2, so treat InputUnits as an estimate and check RefineResult.Consumed.
+var RefineRecipes = []RefineRecipe{
+	{TRADE_SYMBOL_IRON, TRADE_SYMBOL_IRON_ORE, 30, oreRefineries},
+	{TRADE_SYMBOL_COPPER, TRADE_SYMBOL_COPPER_ORE, 30, oreRefineries},
+	{TRADE_SYMBOL_SILVER, TRADE_SYMBOL_SILVER_ORE, 30, oreRefineries},
+	{TRADE_SYMBOL_GOLD, TRADE_SYMBOL_GOLD_ORE, 30, oreRefineries},
+	{TRADE_SYMBOL_ALUMINUM, TRADE_SYMBOL_ALUMINUM_ORE, 30, oreRefineries},
+	{TRADE_SYMBOL_PLATINUM, TRADE_SYMBOL_PLATINUM_ORE, 30, oreRefineries},
+	{TRADE_SYMBOL_URANITE, TRADE_SYMBOL_URANITE_ORE, 30, oreRefineries},
+	{TRADE_SYMBOL_MERITIUM, TRADE_SYMBOL_MERITIUM_ORE, 30, oreRefineries},
+	{TRADE_SYMBOL_FUEL, TRADE_SYMBOL_HYDROCARBON, 30, []ShipModuleSymbol{MODULE_FUEL_REFINERY_I}},
+}
+
+// Refines cargo into produce, one of the Produce values in RefineRecipes.
+// Returns the cooldown in the result so callers can WaitForCooldown.
+func RefineCargo(shipSymbol string, produce TradeSymbol, token string) (*RefineResult, error) {
+	result := new(RefineResult)
+
+	err := shipAction(
+		"POST",
+		shipSymbol,
+		"refine",
+		token,
+		map[string]TradeSymbol{"produce": produce},
+		result,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if result.Cargo == nil {
+		return result, fmt.Errorf(
+			"Refining %s with %s. %w",
+			produce,
+			shipSymbol,
+			NoContentError,
+		)
+	}
+
+	return result, nil
+}
+
+func (self *Ship) hasModule(moduleSymbols []ShipModuleSymbol) bool {
+	for _, module := range self.Modules {
+		for _, symbol := range moduleSymbols {
+			if module.Symbol == symbol {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// The recipes the ship could refine right now,
+// given its modules and what's in its hold.
+func (self *Ship) RefiningOptions() (recipes []RefineRecipe) {
+	for _, recipe := range RefineRecipes {
+		if !self.hasModule(recipe.Modules) {
+			continue
+		}
+
+		if self.Cargo.UnitsOf(recipe.Input) < recipe.InputUnits {
+			continue
+		}
+
+		recipes = append(recipes, recipe)
+	}
+
+	return recipes
+}
@@ -0,0 +1,59 @@
+package space_traders_api
+
+import "github.com/brendoncdodd/space_traders_api/api"
+
+// Generated from the OpenAPI document.
+type (
+	ScannedSystem = api.ScannedSystem
+	ScannedShip   = api.ScannedShip
+)
+
+// POST /my/ships/{shipSymbol}/scan/{target}, decoding data into result.
+// The ship needs a sensor array mount and must not be on cooldown.
+func scan(shipSymbol string, target string, token string, result any) error {
+	return shipAction("POST", shipSymbol, "scan/"+target, token, nil, result)
+}
+
+// Scans for systems around the ship.
+// Returns the cooldown so callers can WaitForCooldown before the next action.
+func ScanSystems(shipSymbol string, token string) ([]ScannedSystem, *ShipCooldown, error) {
+	respObject := new(struct {
+		Cooldown *ShipCooldown
+		Systems  []ScannedSystem
+	})
+
+	err := scan(shipSymbol, "systems", token, respObject)
+
+	return respObject.Systems, respObject.Cooldown, err
+}
+
+// Scans for waypoints around the ship.
+// The scanned waypoints go into the cache, so GetWaypoint and
+// FindNearestWaypointWithTraits see what the sensors saw.
+func ScanWaypoints(shipSymbol string, token string) ([]Waypoint, *ShipCooldown, error) {
+	respObject := new(struct {
+		Cooldown  *ShipCooldown
+		Waypoints []Waypoint
+	})
+
+	err := scan(shipSymbol, "waypoints", token, respObject)
+	if err != nil {
+		return nil, respObject.Cooldown, err
+	}
+
+	cacheWaypoints(respObject.Waypoints)
+
+	return respObject.Waypoints, respObject.Cooldown, nil
+}
+
+// Scans for ships around the ship.
+func ScanShips(shipSymbol string, token string) ([]ScannedShip, *ShipCooldown, error) {
+	respObject := new(struct {
+		Cooldown *ShipCooldown
+		Ships    []ScannedShip
+	})
+
+	err := scan(shipSymbol, "ships", token, respObject)
+
+	return respObject.Ships, respObject.Cooldown, err
+}
@@ -2,6 +2,7 @@ package space_traders_api
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,13 +11,23 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"time"
+
+	"github.com/brendoncdodd/space_traders_api/api"
 )
 
 var (
-	URL_base       *url.URL
-	token_GET      *http.Request
-	token_POST     *http.Request
-	NoContentError = fmt.Errorf("No content from server.")
+	URL_base            *url.URL
+	token_GET           *http.Request
+	token_POST          *http.Request
+	NoContentError      = fmt.Errorf("No content from server.")
+	WrongTokenTypeError = fmt.Errorf("Wrong kind of token.")
+)
+
+// Values of the sub claim in spacetraders.io tokens.
+const (
+	TOKEN_SUBJECT_AGENT   = "agent-token"
+	TOKEN_SUBJECT_ACCOUNT = "account-token"
 )
 
 type STJsonError struct {
@@ -36,8 +47,8 @@ type SaveData struct {
 	Token    string
 	Agent    *Agent
 	Contract *Contract
-	Faction  *any
-	Ship     *any
+	Faction  *Faction
+	Ship     *Ship
 }
 
 func LoadSaveData(filename string) (data *SaveData, err error) {
@@ -64,6 +75,7 @@ func LoadSaveData(filename string) (data *SaveData, err error) {
 		)
 	}
 
+	data = new(SaveData)
 	e = json.Unmarshal(fileData, data)
 	if e != nil {
 		return data, fmt.Errorf(
@@ -87,6 +99,14 @@ func init() {
 	if err != nil {
 		log.Panicln("STAPI: Init. Failed to parse URL.")
 	}
+
+	// Clients made straight from the api package go through the rate limiter too.
+	api.DefaultDo = SendRequest
+}
+
+// The root of the v2 API under URL_base, as set by SetBaseURL.
+func apiBaseURL() string {
+	return strings.TrimRight(URL_base.String(), "/") + "/v2"
 }
 
 func SetBaseURL(rawURL string) (err error) {
@@ -104,9 +124,129 @@ func SetBaseURL(rawURL string) (err error) {
 	return
 }
 
+// The claims spacetraders.io puts in its JWTs.
+// Identifier is the agent symbol for agent tokens.
+type TokenClaims struct {
+	Identifier string `json:"identifier"`
+	Version    string `json:"version"`
+	ResetDate  string `json:"reset_date"`
+	Iat        int64  `json:"iat"`
+	Sub        string `json:"sub"`
+}
+
+func (self TokenClaims) String() string {
+	return fmt.Sprintf(
+		"%s %s (reset %s, issued %s, API %s)",
+		self.Sub,
+		self.Identifier,
+		self.ResetDate,
+		self.IssuedAt().Format(time.RFC3339),
+		self.Version,
+	)
+}
+
+func (self *TokenClaims) IssuedAt() time.Time {
+	return time.Unix(self.Iat, 0).UTC()
+}
+
+func (self *TokenClaims) IsAgentToken() bool {
+	return self.Sub == TOKEN_SUBJECT_AGENT
+}
+
+func (self *TokenClaims) IsAccountToken() bool {
+	return self.Sub == TOKEN_SUBJECT_ACCOUNT
+}
+
+// Decodes the claims of a spacetraders.io JWT without asking the server.
+// The signature isn't checked, only the server can do that.
+func ParseToken(token string) (*TokenClaims, error) {
+	errPrefix := "STAPI: Parsing token."
+	claims := new(TokenClaims)
+
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf(
+			"%s Token has %d parts, a JWT has 3.",
+			errPrefix,
+			len(parts),
+		)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Decoding payload. %w",
+			errPrefix,
+			err,
+		)
+	}
+
+	err = json.Unmarshal(payload, claims)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Decoding claims JSON. %w",
+			errPrefix,
+			err,
+		)
+	}
+
+	if claims.Identifier == "" || claims.Sub == "" {
+		return claims, fmt.Errorf(
+			"%s Token is missing identifier or sub claims.",
+			errPrefix,
+		)
+	}
+
+	return claims, nil
+}
+
+// Parses token and fails unless it's an agent token.
+func RequireAgentToken(token string) (*TokenClaims, error) {
+	claims, err := ParseToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if !claims.IsAgentToken() {
+		return claims, fmt.Errorf(
+			"STAPI: %w Need an agent token, got %s for %s.",
+			WrongTokenTypeError,
+			claims.Sub,
+			claims.Identifier,
+		)
+	}
+
+	return claims, nil
+}
+
+// Parses token and fails unless it's an account token.
+func RequireAccountToken(token string) (*TokenClaims, error) {
+	claims, err := ParseToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if !claims.IsAccountToken() {
+		return claims, fmt.Errorf(
+			"STAPI: %w Need an account token, got %s for %s.",
+			WrongTokenTypeError,
+			claims.Sub,
+			claims.Identifier,
+		)
+	}
+
+	return claims, nil
+}
+
+// Rejects anything that isn't an agent token before setting up the request templates.
 func LoadToken(token string) (err error) {
 	errPrefix := "STAPI: While trying to load token."
 
+	_, err = RequireAgentToken(token)
+	if err != nil {
+		return fmt.Errorf("%s %w", errPrefix, err)
+	}
+
 	token_GET, err = http.NewRequest(
 		"GET",
 		URL_base.String(),
@@ -144,6 +284,55 @@ func LoadToken(token string) (err error) {
 	return nil
 }
 
+// Builds a request for path under URL_base's v2 API, so SetBaseURL applies.
+// body is sent as JSON unless it's nil, and token is left off if it's empty.
+// Caller should close the .Body of the returned request.
+func newRequest(method string, path string, token string, body any) (*http.Request, error) {
+	errPrefix := "Creating " + method + " request for " + path + "."
+	bodyJSON := []byte{}
+
+	if body != nil {
+		var err error
+		bodyJSON, err = json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"%s Encoding body JSON. %w",
+				errPrefix,
+				err,
+			)
+		}
+	}
+
+	req, err := http.NewRequest(
+		method,
+		apiBaseURL()+path,
+		bytes.NewReader(bodyJSON),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", errPrefix, err)
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+		req.ContentLength = int64(len(bodyJSON))
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	return req, nil
+}
+
+// A client for the generated endpoints in the api package
+// that sends through SendRequest like everything else here.
+func apiClient(token string) *api.Client {
+	client := api.NewClient(token)
+	client.BaseURL = apiBaseURL()
+	client.Do = SendRequest
+
+	return client
+}
+
 // Get a spacetraders.io agent token from some JSON.
 // Give this some JSON that follows the pattern:
 // { "data": { "token": [TOKEN] } }
@@ -210,7 +399,7 @@ func SendRequest(req *http.Request) (response []byte, err error) {
 		req.Close = true
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := doRateLimited(req)
 	if err != nil {
 		return nil, fmt.Errorf(
 			"%s\n\tExecuting request.%w",
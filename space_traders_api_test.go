@@ -1,14 +1,21 @@
 package space_traders_api
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"math/rand"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/brendoncdodd/space_traders_api/api"
 )
 
 var new_save SaveData
@@ -310,7 +317,7 @@ func TestGetSystemWaypoints(t *testing.T) {
 	}
 
 	timerGetSystemWaypoints := timer("GetSystemWaypoints(\"X1-UQ22\", []string{\"STRIPPED\"}, \"ASTEROID\")")
-	waypoints, err = GetSystemWaypoints("X1-UQ22", []string{"STRIPPED"}, "ASTEROID")
+	waypoints, err = GetSystemWaypoints("X1-UQ22", []WaypointTraitSymbol{WAYPOINT_TRAIT_STRIPPED}, WAYPOINT_TYPE_ASTEROID)
 	timerGetSystemWaypoints()
 	if err != nil {
 		t.Fatalf(
@@ -329,7 +336,7 @@ func TestGetSystemWaypoints(t *testing.T) {
 			)
 		}
 
-		if waypoint.Type != "ASTEROID" {
+		if waypoint.Type != WAYPOINT_TYPE_ASTEROID {
 			t.Fatalf(
 				"%s Waypoint with index %d is not an ASTEROID.",
 				errPrefix,
@@ -339,7 +346,7 @@ func TestGetSystemWaypoints(t *testing.T) {
 
 		traitsGood := false
 		for _, trait := range waypoint.Traits {
-			if trait.Symbol == "STRIPPED" {
+			if trait.Symbol == WAYPOINT_TRAIT_STRIPPED {
 				traitsGood = true
 			}
 		}
@@ -398,3 +405,1764 @@ func TestGetMyContracts(t *testing.T) {
 		}
 	}
 }
+
+func TestGalaxyFindPath(t *testing.T) {
+	errPrefix := "TEST_GalaxyFindPath():"
+
+	galaxy := NewGalaxy([]System{
+		{Symbol: "X1-A", X: 0, Y: 0, Waypoints: []SystemWaypoint{{Symbol: "X1-A-G", Type: "JUMP_GATE"}}},
+		{Symbol: "X1-B", X: 100, Y: 0, Waypoints: []SystemWaypoint{{Symbol: "X1-B-G", Type: "JUMP_GATE"}}},
+		{Symbol: "X1-C", X: 200, Y: 0, Waypoints: []SystemWaypoint{{Symbol: "X1-C-G", Type: "JUMP_GATE"}}},
+		{Symbol: "X1-D", X: 230, Y: 0},
+	})
+	galaxy.SetJumpGate("X1-A", JumpGate{Symbol: "X1-A-G", Connections: []string{"X1-B-G"}}, false)
+	galaxy.SetJumpGate("X1-B", JumpGate{Symbol: "X1-B-G", Connections: []string{"X1-A-G", "X1-C-G"}}, false)
+	galaxy.SetJumpGate("X1-C", JumpGate{Symbol: "X1-C-G", Connections: []string{"X1-B-G"}}, false)
+
+	path, err := galaxy.FindPath("X1-A", "X1-D", 50)
+	if err != nil {
+		t.Fatalf(
+			"%s Finding path.\n%s",
+			errPrefix,
+			err.Error(),
+		)
+	}
+
+	want := []string{PATH_STEP_JUMP, PATH_STEP_JUMP, PATH_STEP_WARP}
+	if len(path) != len(want) {
+		t.Fatalf(
+			"%s Wrong number of steps.\n%v",
+			errPrefix,
+			path,
+		)
+	}
+	for i, step := range path {
+		if step.Type != want[i] {
+			t.Fatalf(
+				"%s Step %d is %s, want %s.\n%v",
+				errPrefix,
+				i,
+				step.Type,
+				want[i],
+				path,
+			)
+		}
+	}
+
+	galaxy.SetJumpGate("X1-C", JumpGate{Symbol: "X1-C-G", Connections: []string{"X1-B-G"}}, true)
+
+	_, err = galaxy.FindPath("X1-A", "X1-D", 50)
+	if !errors.Is(err, NoPathError) {
+		t.Fatalf(
+			"%s Expected no path through a gate under construction, got %v",
+			errPrefix,
+			err,
+		)
+	}
+
+	// X1-B's gate waypoint can't be got, so X1-A's connection to it mustn't be used.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/systems":
+			fmt.Fprint(w, `{"data": [
+				{"symbol": "X1-A", "x": 0, "y": 0, "waypoints": [{"symbol": "X1-A-G", "type": "JUMP_GATE"}]},
+				{"symbol": "X1-B", "x": 100, "y": 0, "waypoints": [{"symbol": "X1-B-G", "type": "JUMP_GATE"}]}
+			], "meta": {"total": 2, "page": 1, "limit": 20}}`)
+		case "/v2/systems/X1-A/waypoints/X1-A-G":
+			fmt.Fprint(w, `{"data": {"symbol": "X1-A-G", "type": "JUMP_GATE", "systemSymbol": "X1-A"}}`)
+		case "/v2/systems/X1-A/waypoints/X1-A-G/jump-gate":
+			fmt.Fprint(w, `{"data": {"symbol": "X1-A-G", "connections": ["X1-B-G"]}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	oldURL := URL_base.String()
+	SetBaseURL(server.URL)
+	defer SetBaseURL(oldURL)
+
+	galaxy, failed, err := BuildGalaxy()
+	if err != nil {
+		t.Fatalf("%s Building galaxy.\n%s", errPrefix, err.Error())
+	}
+	if failed["X1-B"] == nil || failed["X1-A"] != nil || !galaxy.Nodes["X1-B"].GateUnknown {
+		t.Fatalf("%s Failed lookup not recorded. %v %+v", errPrefix, failed, galaxy.Nodes["X1-B"])
+	}
+
+	_, err = galaxy.FindPath("X1-A", "X1-B", 0)
+	if !errors.Is(err, NoPathError) {
+		t.Fatalf(
+			"%s Expected no path through a gate that couldn't be looked up, got %v",
+			errPrefix,
+			err,
+		)
+	}
+}
+
+func TestFileCache(t *testing.T) {
+	errPrefix := "TEST_FileCache():"
+	filename := t.TempDir() + "/cache.json"
+
+	cache, err := NewFileCache(filename, map[string]time.Duration{CACHE_JUMP_GATE: -time.Second})
+	if err != nil {
+		t.Fatalf("%s Creating cache.\n%s", errPrefix, err.Error())
+	}
+
+	cache.SetResetDate("2024-10-27")
+	cache.Set(CACHE_WAYPOINT, "X1-A-B", Waypoint{Symbol: "X1-A-B", X: 3, Y: 4})
+	cache.Set(CACHE_JUMP_GATE, "X1-A-G", JumpGate{Symbol: "X1-A-G"})
+
+	// Writes wait for SaveDelay, so nothing is on disk until then.
+	if _, err := os.Stat(filename); !os.IsNotExist(err) {
+		t.Fatalf("%s Cache file written before the save delay. %v", errPrefix, err)
+	}
+	err = cache.Flush()
+	if err != nil {
+		t.Fatalf("%s Flushing cache.\n%s", errPrefix, err.Error())
+	}
+
+	cache, err = NewFileCache(filename, map[string]time.Duration{CACHE_JUMP_GATE: -time.Second})
+	if err != nil {
+		t.Fatalf("%s Reloading cache.\n%s", errPrefix, err.Error())
+	}
+
+	waypoint := Waypoint{}
+	ok, err := cache.Get(CACHE_WAYPOINT, "X1-A-B", &waypoint)
+	if err != nil || !ok || waypoint.X != 3 || waypoint.Y != 4 {
+		t.Fatalf(
+			"%s Waypoint didn't survive a reload. ok:%t err:%v waypoint:%v",
+			errPrefix,
+			ok,
+			err,
+			waypoint,
+		)
+	}
+
+	ok, _ = cache.Get(CACHE_JUMP_GATE, "X1-A-G", &JumpGate{})
+	if ok {
+		t.Fatalf("%s Expired jump gate was returned.", errPrefix)
+	}
+
+	cache.SetResetDate("2024-11-10")
+	ok, _ = cache.Get(CACHE_WAYPOINT, "X1-A-B", &waypoint)
+	if ok {
+		t.Fatalf("%s Waypoint survived a server reset.", errPrefix)
+	}
+}
+
+func TestParseToken(t *testing.T) {
+	errPrefix := "TEST_ParseToken():"
+
+	claims, err := RequireAgentToken(TEST_USER_TOKEN)
+	if err != nil {
+		t.Fatalf(
+			"%s Parsing test user token.\n%s",
+			errPrefix,
+			err.Error(),
+		)
+	}
+
+	if claims.Identifier != "TEST_USER" || claims.ResetDate != "2024-10-27" {
+		t.Fatalf(
+			"%s Wrong claims. %v",
+			errPrefix,
+			claims,
+		)
+	}
+
+	_, err = RequireAccountToken(TEST_USER_TOKEN)
+	if !errors.Is(err, WrongTokenTypeError) {
+		t.Fatalf(
+			"%s Agent token accepted as an account token. %v",
+			errPrefix,
+			err,
+		)
+	}
+
+	_, err = ParseToken("not.a-token")
+	if err == nil {
+		t.Fatalf("%s Garbage parsed as a token.", errPrefix)
+	}
+}
+
+func TestValidateMountInstall(t *testing.T) {
+	errPrefix := "TEST_ValidateMountInstall():"
+	ship := Ship{Ship: api.Ship{Symbol: "TEST-1"}}
+	if err := json.Unmarshal([]byte(`{
+		"frame": {"moduleSlots": 2, "mountingPoints": 2, "requirements": {"power": 1, "crew": 1}},
+		"reactor": {"powerOutput": 10, "requirements": {"crew": 1}},
+		"engine": {"requirements": {"power": 2, "crew": 1}},
+		"crew": {"capacity": 5},
+		"mounts": [{"symbol": "MOUNT_MINING_LASER_I", "requirements": {"power": 3, "crew": 1}}]
+	}`), &ship); err != nil {
+		t.Fatalf("%s Decoding test ship.\n%s", errPrefix, err.Error())
+	}
+
+	err := ship.ValidateMountInstall(ShipMount{
+		Symbol:       "MOUNT_SURVEYOR_I",
+		Requirements: &ShipRequirements{Power: 4, Crew: 1},
+	})
+	if err != nil {
+		t.Fatalf("%s Mount should fit.\n%s", errPrefix, err.Error())
+	}
+
+	err = ship.ValidateMountInstall(ShipMount{
+		Symbol:       "MOUNT_MINING_LASER_II",
+		Requirements: &ShipRequirements{Power: 5},
+	})
+	if !errors.Is(err, RequirementsError) {
+		t.Fatalf("%s Mount needs too much power, got %v", errPrefix, err)
+	}
+
+	err = ship.ValidateModuleInstall(ShipModule{
+		Symbol:       "MODULE_CARGO_HOLD_I",
+		Requirements: &ShipRequirements{Slots: 3},
+	})
+	if !errors.Is(err, RequirementsError) {
+		t.Fatalf("%s Module needs too many slots, got %v", errPrefix, err)
+	}
+}
+
+func TestMaintenancePolicy(t *testing.T) {
+	errPrefix := "TEST_MaintenancePolicy():"
+	ship := Ship{Ship: api.Ship{Symbol: "TEST-1"}}
+	if err := json.Unmarshal([]byte(`{
+		"frame": {"condition": 0.9},
+		"reactor": {"condition": 0.35},
+		"engine": {"condition": 0.8}
+	}`), &ship); err != nil {
+		t.Fatalf("%s Decoding test ship.\n%s", errPrefix, err.Error())
+	}
+
+	policy := MaintenancePolicy{ConditionThreshold: 0.5, MinCredits: 1000}
+
+	if repair, reason := policy.ShouldRepair(&ship, 500, 2000); !repair {
+		t.Fatalf("%s Should repair. %s", errPrefix, reason)
+	}
+
+	if repair, reason := policy.ShouldRepair(&ship, 1500, 2000); repair {
+		t.Fatalf("%s Shouldn't repair below the credit reserve. %s", errPrefix, reason)
+	}
+
+	policy.ConditionThreshold = 0.3
+	if repair, reason := policy.ShouldRepair(&ship, 500, 2000); repair {
+		t.Fatalf("%s Shouldn't repair above the threshold. %s", errPrefix, reason)
+	}
+}
+
+func TestWaypointDecoding(t *testing.T) {
+	errPrefix := "TEST_WaypointDecoding():"
+	waypoint := Waypoint{}
+
+	err := json.Unmarshal([]byte(`{
+		"symbol": "X1-DF55-20250Z",
+		"orbitals": [{"symbol": "X1-DF55-20250A"}, {"symbol": "X1-DF55-20250B"}],
+		"faction": {"symbol": "COSMIC"},
+		"traits": [{"symbol": "MARKETPLACE"}],
+		"modifiers": [{"symbol": "STRIPPED"}]
+	}`), &waypoint)
+	if err != nil {
+		t.Fatalf("%s Decoding waypoint.\n%s", errPrefix, err.Error())
+	}
+
+	if orbitals := waypoint.OrbitalSymbols(); len(orbitals) != 2 || orbitals[1] != "X1-DF55-20250B" {
+		t.Fatalf("%s Bad orbitals %v", errPrefix, orbitals)
+	}
+	if waypoint.Faction == nil || waypoint.Faction.Symbol != "COSMIC" {
+		t.Fatalf("%s Bad faction %v", errPrefix, waypoint.Faction)
+	}
+	if !waypoint.HasTrait("MARKETPLACE") || waypoint.HasTrait("SHIPYARD") {
+		t.Fatalf("%s Bad traits %v", errPrefix, waypoint.Traits)
+	}
+	if !waypoint.HasModifier("STRIPPED") {
+		t.Fatalf("%s Bad modifiers %v", errPrefix, waypoint.Modifiers)
+	}
+
+	symbol, err := ParseWaypointSymbol(waypoint.Symbol)
+	if err != nil || symbol.SystemSymbol() != "X1-DF55" || symbol.String() != waypoint.Symbol {
+		t.Fatalf("%s Bad parsed symbol %v %v", errPrefix, symbol, err)
+	}
+
+	_, err = ParseWaypointSymbol("X1-DF55")
+	if err == nil {
+		t.Fatalf("%s System symbol parsed as a waypoint.", errPrefix)
+	}
+}
+
+func TestEnums(t *testing.T) {
+	errPrefix := "TEST_Enums():"
+
+	if err := WAYPOINT_TRAIT_STRIPPED.Validate(); err != nil {
+		t.Fatalf("%s Known trait failed validation. %s", errPrefix, err.Error())
+	}
+
+	if err := WaypointTraitSymbol("STRIPED").Validate(); !errors.Is(err, UnknownEnumError) {
+		t.Fatalf("%s Typo passed validation. %v", errPrefix, err)
+	}
+
+	_, err := GetSystemWaypoints("X1-UQ22", []WaypointTraitSymbol{"STRIPED"}, "")
+	if !errors.Is(err, UnknownEnumError) {
+		t.Fatalf("%s Typo wasn't caught before the request. %v", errPrefix, err)
+	}
+
+	good := MarketTradeGood{}
+	err = json.Unmarshal([]byte(`{"symbol": "SPACE_LLAMAS", "supply": "ABUNDANT"}`), &good)
+	if err != nil {
+		t.Fatalf("%s Unknown trade symbol didn't decode. %s", errPrefix, err.Error())
+	}
+	if good.Symbol.IsKnown() || good.Supply != SUPPLY_ABUNDANT {
+		t.Fatalf("%s Bad decode %v", errPrefix, good)
+	}
+
+	out, _ := json.Marshal(good)
+	if !strings.Contains(string(out), `"SPACE_LLAMAS"`) {
+		t.Fatalf("%s Unknown value didn't round trip. %s", errPrefix, out)
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	errPrefix := "TEST_RateLimiter():"
+	limiter := NewRateLimiter(100, 2)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		err := limiter.Wait(context.Background())
+		if err != nil {
+			t.Fatalf("%s Waiting. %s", errPrefix, err.Error())
+		}
+	}
+
+	// Two from the burst, then two at 10ms each.
+	if elapsed := time.Since(start); elapsed < 15*time.Millisecond {
+		t.Fatalf("%s Didn't wait after the burst. %s", errPrefix, elapsed)
+	}
+
+	// The burst is used up and the next token is 1000s away.
+	limiter = NewRateLimiter(0.001, 1)
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("%s Waiting for the burst. %s", errPrefix, err.Error())
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start = time.Now()
+	if err := limiter.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("%s Wait ignored the context. %v", errPrefix, err)
+	}
+	if elapsed := time.Since(start); elapsed < 15*time.Millisecond || elapsed > time.Second {
+		t.Fatalf("%s Wait didn't block until the context was done. %s", errPrefix, elapsed)
+	}
+
+	// A burst of 0 would never let anything through, and a rate of 0 would never refill.
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	limiter = NewRateLimiter(100, 0)
+	if err := limiter.Wait(ctx); err != nil {
+		t.Fatalf("%s Burst of 0 blocked. %s", errPrefix, err.Error())
+	}
+	limiter = NewRateLimiter(0, 1)
+	for i := 0; i < 3; i++ {
+		if err := limiter.Wait(ctx); err != nil {
+			t.Fatalf("%s Rate of 0 should be unlimited. %s", errPrefix, err.Error())
+		}
+	}
+
+	// Clients from the api package share the limiter.
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"data": {"symbol": "X1-A-B", "connections": []}}`)
+	}))
+	defer server.Close()
+
+	limiter = NewRateLimiter(0.001, 1)
+	SetRateLimiter(limiter)
+	defer SetRateLimiter(NewRateLimiter(RATE_LIMIT_PER_SECOND, RATE_LIMIT_BURST))
+	client := api.NewClient("")
+	client.BaseURL = server.URL
+	if _, err := client.GetJumpGate("X1-A", "X1-A-B"); err != nil {
+		t.Fatalf("%s Getting jump gate. %s", errPrefix, err.Error())
+	}
+	if limiter.reserve() <= 0 {
+		t.Fatalf("%s api.Client didn't take a token from the shared limiter.", errPrefix)
+	}
+	if requests != 1 {
+		t.Fatalf("%s Expected 1 request, got %d.", errPrefix, requests)
+	}
+}
+
+func TestRunner(t *testing.T) {
+	errPrefix := "TEST_Runner():"
+
+	runner := NewRunner("")
+	runner.RestartDelay = time.Millisecond
+	runner.Refresh = func(shipSymbol string, token string) (*Ship, error) {
+		return &Ship{Ship: api.Ship{Symbol: shipSymbol}}, nil
+	}
+
+	errs := []error{}
+	runner.OnError = func(shipSymbol string, err error) {
+		errs = append(errs, err)
+	}
+
+	steps := 0
+	behavior := BehaviorFunc(func(ctx context.Context, ship *Ship, token string) (BehaviorStep, error) {
+		steps++
+		switch steps {
+		case 1:
+			panic("first step")
+		case 2:
+			ship.Nav = &ShipNav{
+				Status: NAV_STATUS_IN_TRANSIT,
+				Route: &ShipNavRoute{
+					Destination: &ShipNavRouteWaypoint{Symbol: "X1-A-B"},
+					Arrival:     time.Now().Format(time.RFC3339),
+				},
+			}
+			return BehaviorStep{Action: "navigating"}, nil
+		}
+
+		if ship.Nav.Status != NAV_STATUS_IN_ORBIT || ship.Nav.WaypointSymbol != "X1-A-B" {
+			return BehaviorStep{}, fmt.Errorf("Ship didn't arrive. %v", ship.Nav)
+		}
+		return BehaviorStep{Done: true}, nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	runner.Start(ctx, Ship{Ship: api.Ship{Symbol: "TEST-1"}}, behavior)
+	runner.Wait()
+
+	if len(errs) != 1 || !errors.Is(errs[0], BehaviorPanicError) {
+		t.Fatalf("%s Expected one recovered panic, got %v", errPrefix, errs)
+	}
+	if steps != 3 {
+		t.Fatalf("%s Expected 3 steps, got %d", errPrefix, steps)
+	}
+	if running := runner.Running(); len(running) != 0 {
+		t.Fatalf("%s Ships still running %v", errPrefix, running)
+	}
+}
+
+func TestMiningBestSurvey(t *testing.T) {
+	errPrefix := "TEST_MiningBestSurvey():"
+	future := time.Now().Add(time.Hour).Format(time.RFC3339)
+	past := time.Now().Add(-time.Hour).Format(time.RFC3339)
+
+	behavior := &MiningBehavior{
+		Keep: func(tradeSymbol TradeSymbol) bool {
+			return tradeSymbol == TRADE_SYMBOL_IRON_ORE
+		},
+		surveys: []Survey{
+			{
+				Signature:  "EXPIRED",
+				Expiration: past,
+				Size:       SURVEY_SIZE_LARGE,
+				Deposits:   []SurveyDeposit{{Symbol: string(TRADE_SYMBOL_IRON_ORE)}},
+			},
+			{
+				Signature:  "QUARTZ",
+				Expiration: future,
+				Size:       SURVEY_SIZE_LARGE,
+				Deposits:   []SurveyDeposit{{Symbol: string(TRADE_SYMBOL_QUARTZ_SAND)}},
+			},
+			{
+				Signature:  "HALF",
+				Expiration: future,
+				Size:       SURVEY_SIZE_MODERATE,
+				Deposits:   []SurveyDeposit{{Symbol: string(TRADE_SYMBOL_IRON_ORE)}, {Symbol: string(TRADE_SYMBOL_ICE_WATER)}},
+			},
+			{
+				Signature:  "SMALL",
+				Expiration: future,
+				Size:       SURVEY_SIZE_SMALL,
+				Deposits:   []SurveyDeposit{{Symbol: string(TRADE_SYMBOL_IRON_ORE)}, {Symbol: string(TRADE_SYMBOL_ICE_WATER)}},
+			},
+		},
+	}
+
+	best := behavior.bestSurvey()
+	if best == nil || best.Signature != "HALF" {
+		t.Fatalf("%s Bad survey choice %v", errPrefix, best)
+	}
+	if len(behavior.surveys) != 3 {
+		t.Fatalf("%s Expired survey wasn't dropped.", errPrefix)
+	}
+
+	behavior.dropSurvey("HALF")
+	behavior.dropSurvey("SMALL")
+	if best := behavior.bestSurvey(); best != nil {
+		t.Fatalf(
+			"%s Chose a survey with nothing wanted in it.\n%v",
+			errPrefix,
+			best,
+		)
+	}
+}
+
+func TestMiningUnload(t *testing.T) {
+	errPrefix := "TEST_MiningUnload():"
+
+	// X1-A-2 lists iron ore as an import, but once a ship is there it has no price for it.
+	jettisoned := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/systems/X1-A/waypoints/X1-A-2/market":
+			fmt.Fprint(w, `{"data": {
+				"symbol": "X1-A-2",
+				"imports": [{"symbol": "IRON_ORE"}],
+				"tradeGoods": [{"symbol": "COPPER", "sellPrice": 50, "tradeVolume": 10}]
+			}}`)
+		case "/v2/my/ships/TEST-1/jettison":
+			jettisoned++
+			fmt.Fprint(w, `{"data": {"cargo": {"capacity": 30, "units": 0, "inventory": []}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	oldURL := URL_base.String()
+	SetBaseURL(server.URL)
+	defer SetBaseURL(oldURL)
+
+	ship := &Ship{
+		Ship: api.Ship{
+			Symbol: "TEST-1",
+			Nav:    &ShipNav{WaypointSymbol: "X1-A-2", Status: NAV_STATUS_DOCKED},
+			Cargo: &ShipCargo{
+				Capacity:  30,
+				Units:     30,
+				Inventory: []ShipCargoItem{{Symbol: TRADE_SYMBOL_IRON_ORE, Units: 30}},
+			},
+		},
+	}
+	behavior := &MiningBehavior{
+		Asteroid:  "X1-A-1",
+		selling:   true,
+		markets:   map[string]*Market{"X1-A-2": {Market: api.Market{Symbol: "X1-A-2", Imports: []TradeGood{{Symbol: TRADE_SYMBOL_IRON_ORE}}}}},
+		distances: map[string]float64{"X1-A-2": 10},
+	}
+
+	for i := 0; i < 5 && behavior.selling; i++ {
+		_, err := behavior.unload(ship, "")
+		if err != nil {
+			t.Fatalf("%s Unloading.\n%s", errPrefix, err.Error())
+		}
+	}
+
+	if behavior.selling || jettisoned != 1 || ship.Cargo.Units != 0 {
+		t.Fatalf(
+			"%s Kept going back to a market that doesn't buy the ore. selling:%t jettisoned:%d cargo:%v",
+			errPrefix,
+			behavior.selling,
+			jettisoned,
+			ship.Cargo,
+		)
+	}
+}
+
+func TestFindTradeRoutes(t *testing.T) {
+	errPrefix := "TEST_FindTradeRoutes():"
+
+	locations := map[string]Vector2{
+		"X1-A-1": {0, 0},
+		"X1-A-2": {30, 40},
+		"X1-A-3": {300, 400},
+	}
+	distance := func(from string, to string) (float64, error) {
+		location := locations[from]
+		return location.Distance(locations[to]), nil
+	}
+
+	markets := []Market{
+		{
+			Market: api.Market{
+				Symbol: "X1-A-1",
+				TradeGoods: []MarketTradeGood{
+					{Symbol: TRADE_SYMBOL_IRON, TradeVolume: 10, Supply: SUPPLY_ABUNDANT, PurchasePrice: 100, SellPrice: 90},
+					{Symbol: TRADE_SYMBOL_FUEL, TradeVolume: 100, Supply: SUPPLY_HIGH, PurchasePrice: 72, SellPrice: 60},
+				},
+			},
+		},
+		{
+			Market: api.Market{
+				Symbol: "X1-A-2",
+				TradeGoods: []MarketTradeGood{
+					{Symbol: TRADE_SYMBOL_IRON, TradeVolume: 10, Supply: SUPPLY_SCARCE, PurchasePrice: 160, SellPrice: 150},
+				},
+			},
+		},
+		{
+			Market: api.Market{
+				Symbol: "X1-A-3",
+				TradeGoods: []MarketTradeGood{
+					{Symbol: TRADE_SYMBOL_IRON, TradeVolume: 10, Supply: SUPPLY_SCARCE, PurchasePrice: 200, SellPrice: 190},
+				},
+			},
+		},
+	}
+
+	ship := TradeShip{WaypointSymbol: "X1-A-1", CargoCapacity: 40, FuelCapacity: 1000, Speed: 30}
+	routes, err := FindTradeRoutes(markets, ship, TradeRouteOptions{Distance: distance})
+	if err != nil {
+		t.Fatalf("%s Finding routes.\n%s", errPrefix, err.Error())
+	}
+
+	// X1-A-2 to X1-A-3 doesn't cover the fuel to get there.
+	if len(routes) != 2 {
+		t.Fatalf("%s Expected 2 routes, got %v", errPrefix, routes)
+	}
+	if routes[0].Buy != "X1-A-1" || routes[0].Sell != "X1-A-2" {
+		t.Fatalf("%s The short route should pay best per hour. %v", errPrefix, routes)
+	}
+	// 50 fuel is one market unit of 100.
+	if routes[0].FuelCost != 72 {
+		t.Fatalf("%s Bad fuel cost %d", errPrefix, routes[0].FuelCost)
+	}
+	if routes[0].SellPrice >= 150 || routes[0].BuyPrice <= 100 {
+		t.Fatalf("%s Prices didn't move with volume. %v", errPrefix, routes[0])
+	}
+
+	ship.FuelCapacity = 400
+	routes, _ = FindTradeRoutes(markets, ship, TradeRouteOptions{Distance: distance})
+	for _, route := range routes {
+		if route.Sell == "X1-A-3" || route.Buy == "X1-A-3" {
+			t.Fatalf("%s Route out of fuel range. %v", errPrefix, route)
+		}
+	}
+}
+
+func TestContractBehavior(t *testing.T) {
+	errPrefix := "TEST_ContractBehavior():"
+
+	markets := []Market{
+		{Market: api.Market{Symbol: "X1-A-1", Exports: []TradeGood{{Symbol: TRADE_SYMBOL_COPPER}}}},
+		{Market: api.Market{Symbol: "X1-A-2", TradeGoods: []MarketTradeGood{{Symbol: TRADE_SYMBOL_COPPER, PurchasePrice: 80}}}},
+		{Market: api.Market{Symbol: "X1-A-3", TradeGoods: []MarketTradeGood{{Symbol: TRADE_SYMBOL_COPPER, PurchasePrice: 60}}}},
+	}
+
+	if source, price := cheapestSource(markets, TRADE_SYMBOL_COPPER); source != "X1-A-3" || price != 60 {
+		t.Fatalf("%s Bad cheapest source %s %d", errPrefix, source, price)
+	}
+	if source, _ := cheapestSource(markets[:1], TRADE_SYMBOL_COPPER); source != "X1-A-1" {
+		t.Fatalf("%s Unpriced exporter wasn't a fallback. %s", errPrefix, source)
+	}
+	if source, _ := cheapestSource(markets, TRADE_SYMBOL_GOLD); source != "" {
+		t.Fatalf("%s Found a source for a good nobody sells. %s", errPrefix, source)
+	}
+
+	reports := []ContractReport{}
+	behavior := NewContractBehavior(&Contract{ID: "TEST"})
+	behavior.OnDone = func(report ContractReport) {
+		reports = append(reports, report)
+	}
+
+	step, err := behavior.Step(context.Background(), &Ship{Ship: api.Ship{Symbol: "TEST-1"}}, "")
+	if err != nil || !step.Done {
+		t.Fatalf("%s Unaccepted contract wasn't aborted. %v %v", errPrefix, step, err)
+	}
+	if len(reports) != 1 || !reports[0].Aborted {
+		t.Fatalf("%s Bad reports %v", errPrefix, reports)
+	}
+
+	// The ship is at the only market, which is also the destination, so nothing is flown.
+	contract := Contract{ID: "COPPER", Accepted: true}
+	contract.Terms = &ContractTerms{Payment: &ContractPayment{OnFulfilled: 100}}
+	contract.Terms.Deliver = append(contract.Terms.Deliver, ContractDeliverGood{
+		TradeSymbol:       string(TRADE_SYMBOL_COPPER),
+		DestinationSymbol: "X1-A-2",
+		UnitsRequired:     10,
+	})
+	ship := &Ship{Ship: api.Ship{Symbol: "TEST_USER-1", Nav: &ShipNav{WaypointSymbol: "X1-A-2"}}}
+
+	behavior = NewContractBehavior(&contract)
+	behavior.Markets = []Market{{Market: api.Market{Symbol: "X1-A-2", Exports: []TradeGood{{Symbol: TRADE_SYMBOL_COPPER}}}}}
+	if reason := behavior.project(ship); !strings.Contains(reason, "no prices") {
+		t.Fatalf("%s Unpriced goods didn't abort. %q", errPrefix, reason)
+	}
+	if len(behavior.Report.Unpriced) != 1 {
+		t.Fatalf("%s Unpriced goods weren't reported. %v", errPrefix, behavior.Report)
+	}
+	behavior.AllowUnpriced = true
+	if reason := behavior.project(ship); reason != "" {
+		t.Fatalf("%s AllowUnpriced still aborted. %q", errPrefix, reason)
+	}
+
+	history, err := NewFilePriceHistory(t.TempDir() + "/prices.jsonl")
+	if err != nil {
+		t.Fatalf("%s Creating price history. %s", errPrefix, err.Error())
+	}
+	err = history.Record(Market{
+		Market: api.Market{
+			Symbol:     "X1-A-2",
+			TradeGoods: []MarketTradeGood{{Symbol: TRADE_SYMBOL_COPPER, PurchasePrice: 20}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("%s Recording prices. %s", errPrefix, err.Error())
+	}
+	history.fillPrices(behavior.Markets)
+
+	behavior.AllowUnpriced = false
+	if reason := behavior.project(ship); !strings.Contains(reason, "200c is more than the 100c") {
+		t.Fatalf("%s Recorded price didn't make it too expensive. %q", errPrefix, reason)
+	}
+
+	// A hold full of other goods is cleared before buying: ice water is sold, quartz jettisoned.
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+
+		switch r.URL.Path {
+		case "/v2/systems/X1-A/waypoints/X1-A-2/market":
+			fmt.Fprint(w, `{"data": {"symbol": "X1-A-2", "tradeGoods": [
+				{"symbol": "COPPER", "purchasePrice": 20, "tradeVolume": 10},
+				{"symbol": "ICE_WATER", "sellPrice": 10, "tradeVolume": 10}
+			]}}`)
+		case "/v2/my/ships/TEST-1/sell":
+			fmt.Fprint(w, `{"data": {
+				"cargo": {"capacity": 10, "units": 5, "inventory": [{"symbol": "QUARTZ_SAND", "units": 5}]},
+				"transaction": {"totalPrice": 50}
+			}}`)
+		case "/v2/my/ships/TEST-1/jettison":
+			fmt.Fprint(w, `{"data": {"cargo": {"capacity": 10, "units": 0, "inventory": []}}}`)
+		case "/v2/my/ships/TEST-1/purchase":
+			fmt.Fprint(w, `{"data": {
+				"cargo": {"capacity": 10, "units": 10, "inventory": [{"symbol": "COPPER", "units": 10}]},
+				"transaction": {"totalPrice": 200}
+			}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	oldURL := URL_base.String()
+	SetBaseURL(server.URL)
+	defer SetBaseURL(oldURL)
+
+	contract.Terms.Deliver[0].DestinationSymbol = "X1-A-3"
+	ship = &Ship{
+		Ship: api.Ship{
+			Symbol: "TEST-1",
+			Nav:    &ShipNav{WaypointSymbol: "X1-A-2", Status: NAV_STATUS_DOCKED},
+			Cargo: &ShipCargo{
+				Capacity: 10,
+				Units:    10,
+				Inventory: []ShipCargoItem{
+					{Symbol: TRADE_SYMBOL_ICE_WATER, Units: 5},
+					{Symbol: TRADE_SYMBOL_QUARTZ_SAND, Units: 5},
+				},
+			},
+		},
+	}
+	behavior = NewContractBehavior(&contract)
+	behavior.Markets = []Market{{Market: api.Market{Symbol: "X1-A-2", TradeGoods: []MarketTradeGood{{Symbol: TRADE_SYMBOL_COPPER, PurchasePrice: 20}}}}}
+	behavior.checked = true
+
+	for i := 0; i < 2; i++ {
+		step, err := behavior.Step(context.Background(), ship, "")
+		if err != nil || step.Done {
+			t.Fatalf("%s Step %d with a full hold. %v %v %v", errPrefix, i, step, err, behavior.Report)
+		}
+	}
+	if ship.Cargo.UnitsOf(TRADE_SYMBOL_COPPER) != 10 || behavior.Report.Earned != 50 || behavior.Report.Spent != 200 {
+		t.Fatalf("%s Hold wasn't cleared for copper. %v %v %v", errPrefix, ship.Cargo, behavior.Report, requests)
+	}
+}
+
+func TestRankContracts(t *testing.T) {
+	errPrefix := "TEST_RankContracts():"
+
+	locations := map[string]Vector2{
+		"X1-A-1": {0, 0},
+		"X1-A-2": {0, 100},
+	}
+	options := TradeRouteOptions{
+		FuelPrice: 100,
+		Distance: func(from string, to string) (float64, error) {
+			location := locations[from]
+			return location.Distance(locations[to]), nil
+		},
+	}
+	markets := []Market{
+		{Market: api.Market{Symbol: "X1-A-1", TradeGoods: []MarketTradeGood{{Symbol: TRADE_SYMBOL_COPPER, PurchasePrice: 10}}}},
+	}
+	ships := []TradeShip{
+		{Symbol: "SLOW", WaypointSymbol: "X1-A-2", CargoCapacity: 40, FuelCapacity: 400, Speed: 1},
+		{Symbol: "FAST", WaypointSymbol: "X1-A-2", CargoCapacity: 40, FuelCapacity: 400, Speed: 30},
+	}
+
+	newContract := func(id string, tradeSymbol TradeSymbol, units int, pay int, deadline time.Duration) Contract {
+		contract := Contract{ID: id, Terms: &ContractTerms{Payment: &ContractPayment{}}}
+		contract.Terms.Deadline = time.Now().Add(deadline).Format(time.RFC3339)
+		contract.Terms.Payment.OnAccepted = pay / 4
+		contract.Terms.Payment.OnFulfilled = pay - pay/4
+		contract.Terms.Deliver = append(contract.Terms.Deliver, ContractDeliverGood{
+			TradeSymbol:       string(tradeSymbol),
+			DestinationSymbol: "X1-A-2",
+			UnitsRequired:     units,
+		})
+		return contract
+	}
+
+	contracts := []Contract{
+		newContract("SMALL", TRADE_SYMBOL_COPPER, 40, 2000, 24*time.Hour),
+		newContract("BIG", TRADE_SYMBOL_COPPER, 80, 5000, 24*time.Hour),
+		newContract("GOLD", TRADE_SYMBOL_GOLD, 10, 90000, 24*time.Hour),
+		newContract("LATE", TRADE_SYMBOL_COPPER, 400, 90000, time.Minute),
+	}
+
+	ranked := RankContracts(contracts, markets, ships, options)
+	if len(ranked) != 4 {
+		t.Fatalf("%s Expected 4 evaluations, got %d", errPrefix, len(ranked))
+	}
+	if ranked[0].Contract.ID != "BIG" || ranked[1].Contract.ID != "SMALL" {
+		t.Fatalf("%s Bad ranking.\n%v", errPrefix, ranked)
+	}
+	if ranked[0].ShipSymbol != "FAST" {
+		t.Fatalf("%s Slow ship chosen. %v", errPrefix, ranked[0])
+	}
+	// 80 units at 10c, two round trips of 100 fuel each way, 4 market units of fuel at 100c.
+	if ranked[0].SourcingCost != 800 || ranked[0].FuelCost != 400 || ranked[0].Profit != 3800 {
+		t.Fatalf("%s Bad numbers. %v", errPrefix, ranked[0])
+	}
+	for _, evaluation := range ranked[2:] {
+		if evaluation.Feasible {
+			t.Fatalf("%s Should be infeasible. %v", errPrefix, evaluation)
+		}
+	}
+
+	// X1-A-2 exports iron but has no ship there, so iron has no price.
+	// It would look like the best contract if it counted as free.
+	markets = append(markets, Market{Market: api.Market{Symbol: "X1-A-2", Exports: []TradeGood{{Symbol: TRADE_SYMBOL_IRON}}}})
+	contracts = append(contracts, newContract("UNPRICED", TRADE_SYMBOL_IRON, 10, 50000, 24*time.Hour))
+	options.MaxResults = 1
+
+	ranked = RankContracts(contracts, markets, ships, options)
+	if len(ranked) != 1 || ranked[0].Contract.ID != "BIG" {
+		t.Fatalf("%s Unpriced contract pushed out a priced one.\n%v", errPrefix, ranked)
+	}
+
+	options.AllowUnpriced = true
+	ranked = RankContracts(contracts, markets, ships, options)
+	if len(ranked) != 1 || ranked[0].Contract.ID != "UNPRICED" || !ranked[0].Feasible ||
+		len(ranked[0].Unpriced) != 1 {
+		t.Fatalf("%s AllowUnpriced didn't count iron as free.\n%v", errPrefix, ranked)
+	}
+}
+
+func TestCoordinatorPlan(t *testing.T) {
+	errPrefix := "TEST_CoordinatorPlan():"
+
+	locations := map[string]Vector2{
+		"X1-A-1": {0, 0},
+		"X1-A-2": {100, 0},
+		"X1-A-3": {200, 0},
+	}
+	newShip := func(symbol string, role ShipRole, waypoint string) Ship {
+		ship := Ship{Ship: api.Ship{Symbol: symbol, Nav: &ShipNav{WaypointSymbol: waypoint}}}
+		ship.Registration = &ShipRegistration{Role: role}
+		return ship
+	}
+	idle := BehaviorFunc(func(ctx context.Context, ship *Ship, token string) (BehaviorStep, error) {
+		return BehaviorStep{Done: true}, nil
+	})
+
+	coordinator := NewCoordinator("", nil)
+	coordinator.Distance = func(from string, to string) (float64, error) {
+		location := locations[from]
+		return location.Distance(locations[to]), nil
+	}
+	coordinator.AddJob(Job{
+		ID: "MINE", Waypoint: "X1-A-3", Roles: []ShipRole{SHIP_ROLE_EXCAVATOR}, Slots: 2,
+		Behavior: func(ship *Ship) Behavior { return idle },
+	})
+	coordinator.AddJob(Job{
+		ID: "HAUL-1", Waypoint: "X1-A-1", Markets: []string{"X1-A-2"},
+		Behavior: func(ship *Ship) Behavior { return idle },
+	})
+	coordinator.AddJob(Job{
+		ID: "HAUL-2", Waypoint: "X1-A-1", Markets: []string{"X1-A-2"},
+		Behavior: func(ship *Ship) Behavior { return idle },
+	})
+
+	ships := []Ship{
+		newShip("MINER-FAR", SHIP_ROLE_EXCAVATOR, "X1-A-1"),
+		newShip("MINER-NEAR", SHIP_ROLE_EXCAVATOR, "X1-A-3"),
+		newShip("HAULER-1", SHIP_ROLE_HAULER, "X1-A-2"),
+		newShip("HAULER-2", SHIP_ROLE_HAULER, "X1-A-2"),
+	}
+
+	distances := coordinator.jobDistances(ships)
+	coordinator.mutex.Lock()
+	planned := coordinator.plan(ships, distances)
+	assignments := coordinator.assignments
+	coordinator.mutex.Unlock()
+
+	if assignments["MINER-NEAR"] != "MINE" || assignments["MINER-FAR"] != "MINE" {
+		t.Fatalf("%s Miners not mining. %v", errPrefix, assignments)
+	}
+	haulers := 0
+	for _, jobID := range assignments {
+		if jobID == "HAUL-1" || jobID == "HAUL-2" {
+			haulers++
+		}
+	}
+	if haulers != 1 {
+		t.Fatalf("%s Two ships sent to the same market. %v", errPrefix, planned)
+	}
+
+	// A scrapped miner frees its slot, and an urgent job takes the idle hauler first.
+	coordinator.AddJob(Job{
+		ID: "PROBE", Priority: 10,
+		Behavior: func(ship *Ship) Behavior { return idle },
+	})
+
+	distances = coordinator.jobDistances(ships[1:])
+	coordinator.mutex.Lock()
+	coordinator.prune(ships[1:], nil)
+	planned = coordinator.plan(ships[1:], distances)
+	coordinator.mutex.Unlock()
+
+	if len(planned) != 1 || planned[0].JobID != "PROBE" || planned[0].PreviousJobID != "" {
+		t.Fatalf("%s Bad rebalance. %v", errPrefix, planned)
+	}
+	if _, ok := coordinator.Assignments()["MINER-FAR"]; ok {
+		t.Fatalf("%s Scrapped ship still assigned.", errPrefix)
+	}
+
+	// Once one ship finishes a job that doesn't repeat, the others on it are stopped.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	waiting := BehaviorFunc(func(ctx context.Context, ship *Ship, token string) (BehaviorStep, error) {
+		<-ctx.Done()
+		return BehaviorStep{Done: true}, nil
+	})
+	coordinator.Runner.Refresh = func(shipSymbol string, token string) (*Ship, error) {
+		return &Ship{Ship: api.Ship{Symbol: shipSymbol}}, nil
+	}
+	coordinator.Runner.Start(ctx, Ship{Ship: api.Ship{Symbol: "MINER-NEAR"}}, waiting)
+
+	mine := coordinator.jobs["MINE"]
+	finished := &jobBehavior{coordinator: coordinator, job: mine, inner: idle}
+	coordinator.mutex.Lock()
+	coordinator.assignments["MINER-NEAR"] = "MINE"
+	coordinator.assignments["MINER-OTHER"] = "MINE"
+	coordinator.mutex.Unlock()
+
+	if _, err := finished.Step(ctx, &Ship{Ship: api.Ship{Symbol: "MINER-OTHER"}}, ""); err != nil {
+		t.Fatalf("%s Finishing job. %s", errPrefix, err.Error())
+	}
+	if _, ok := coordinator.jobs["MINE"]; ok {
+		t.Fatalf("%s Finished job wasn't removed.", errPrefix)
+	}
+	for shipSymbol, jobID := range coordinator.Assignments() {
+		if jobID == "MINE" {
+			t.Fatalf("%s %s still assigned to the finished job.", errPrefix, shipSymbol)
+		}
+	}
+	for _, shipSymbol := range coordinator.Runner.Running() {
+		if shipSymbol == "MINER-NEAR" {
+			t.Fatalf("%s Other ship on the finished job wasn't stopped.", errPrefix)
+		}
+	}
+	coordinator.Runner.Wait()
+}
+
+func TestProbeScheduler(t *testing.T) {
+	errPrefix := "TEST_ProbeScheduler():"
+
+	locations := map[string]Vector2{
+		"X1-A-1": {0, 0},
+		"X1-A-2": {10, 0},
+		"X1-A-3": {20, 0},
+		"X1-A-4": {30, 0},
+	}
+	now := time.Now()
+
+	scheduler := NewProbeScheduler(nil)
+	scheduler.Markets = []string{"X1-A-1", "X1-A-2", "X1-A-3", "X1-A-4", "X1-B-1"}
+	scheduler.Interval = 10 * time.Minute
+	scheduler.MaxTour = 2
+	// Location and Value can be slow, so they shouldn't be called with the mutex held.
+	locked := false
+	checkUnlocked := func() {
+		if scheduler.mutex.TryLock() {
+			scheduler.mutex.Unlock()
+		} else {
+			locked = true
+		}
+	}
+	scheduler.Location = func(waypointSymbol string) (Vector2, error) {
+		checkUnlocked()
+		location, ok := locations[waypointSymbol]
+		if !ok {
+			return Vector2{}, fmt.Errorf("No location for %s.", waypointSymbol)
+		}
+		return location, nil
+	}
+	scheduler.Value = func(waypointSymbol string) float64 {
+		checkUnlocked()
+		if waypointSymbol == "X1-A-2" {
+			return 10
+		}
+		return 0
+	}
+	scheduler.Observed("X1-A-1", now.Add(-time.Minute))
+	scheduler.Observed("X1-A-2", now.Add(-20*time.Minute))
+	scheduler.Observed("X1-A-3", now.Add(-time.Hour))
+
+	// A-4 has never been seen and A-2 matters most. A-1 isn't due and B-1 is in another system.
+	tour := scheduler.planTour("PROBE-1", "X1-A", "X1-A-1", now)
+	if len(tour) != 2 || tour[0] != "X1-A-2" || tour[1] != "X1-A-4" {
+		t.Fatalf("%s Wrong tour. %v", errPrefix, tour)
+	}
+
+	tour = scheduler.planTour("PROBE-2", "X1-A", "X1-A-1", now)
+	if len(tour) != 1 || tour[0] != "X1-A-3" {
+		t.Fatalf("%s Second probe not given what's left. %v", errPrefix, tour)
+	}
+
+	next := scheduler.nextDue("PROBE-3", "X1-A", now)
+	if !next.Equal(now.Add(-time.Minute).Add(10 * time.Minute)) {
+		t.Fatalf("%s Wrong next due time. %v", errPrefix, next.Sub(now))
+	}
+
+	scheduler.Park = true
+	market := scheduler.parkAt("PROBE-3", "X1-A", "X1-A-4", now)
+	if market != "X1-A-1" {
+		t.Fatalf("%s Parked at %s, not the only unclaimed market.", errPrefix, market)
+	}
+	if market := scheduler.parkAt("PROBE-4", "X1-A", "X1-A-4", now); market != "" {
+		t.Fatalf("%s Parked at %s when every market is claimed.", errPrefix, market)
+	}
+	if locked {
+		t.Fatalf("%s Location or Value was called with the mutex held.", errPrefix)
+	}
+}
+
+func TestFilePriceHistory(t *testing.T) {
+	errPrefix := "TEST_FilePriceHistory():"
+	filename := t.TempDir() + "/prices.jsonl"
+
+	history, err := NewFilePriceHistory(filename)
+	if err != nil {
+		t.Fatalf("%s Creating history. %v", errPrefix, err)
+	}
+
+	start := time.Date(2024, 11, 1, 12, 0, 0, 0, time.UTC)
+	prices := [][2]int{{100, 90}, {120, 110}, {80, 70}}
+	for i, price := range prices {
+		err = history.Record(Market{
+			Market: api.Market{
+				Symbol: "X1-A-1",
+				TradeGoods: []MarketTradeGood{
+					{Symbol: TRADE_SYMBOL_FUEL, Supply: SUPPLY_MODERATE, PurchasePrice: price[0], SellPrice: price[1]},
+					{Symbol: TRADE_SYMBOL_IRON_ORE, PurchasePrice: 10, SellPrice: 8},
+				},
+			},
+			ObservedAt: start.Add(time.Duration(i) * time.Hour),
+		})
+		if err != nil {
+			t.Fatalf("%s Recording. %v", errPrefix, err)
+		}
+	}
+	// No prices, nothing recorded.
+	history.Record(Market{Market: api.Market{Symbol: "X1-A-2"}, ObservedAt: start})
+
+	// Everything should come back from the file.
+	history, err = NewFilePriceHistory(filename)
+	if err != nil {
+		t.Fatalf("%s Reloading history. %v", errPrefix, err)
+	}
+
+	latest, ok := history.Latest("X1-A-1", TRADE_SYMBOL_FUEL)
+	if !ok || latest.PurchasePrice != 80 || !latest.Timestamp.Equal(start.Add(2*time.Hour)) {
+		t.Fatalf("%s Wrong latest price. %+v", errPrefix, latest)
+	}
+	if _, ok := history.Latest("X1-A-2", TRADE_SYMBOL_FUEL); ok {
+		t.Fatalf("%s Market without prices was recorded.", errPrefix)
+	}
+
+	fuel := PriceQuery{WaypointSymbol: "X1-A-1", TradeSymbol: TRADE_SYMBOL_FUEL}
+	if records := history.Prices(fuel); len(records) != 3 || records[1].PurchasePrice != 120 {
+		t.Fatalf("%s Wrong price history. %+v", errPrefix, records)
+	}
+
+	stats := history.Stats(fuel)
+	if stats.Count != 3 || stats.MinPurchase != 80 || stats.MaxPurchase != 120 || stats.AvgPurchase != 100 {
+		t.Fatalf("%s Wrong stats. %+v", errPrefix, stats)
+	}
+	if stats.PurchaseTrend() != -0.2 {
+		t.Fatalf("%s Wrong trend %f.", errPrefix, stats.PurchaseTrend())
+	}
+
+	fuel.From = start.Add(time.Hour)
+	fuel.To = start.Add(2 * time.Hour)
+	if stats := history.Stats(fuel); stats.Count != 1 || stats.MinSell != 110 {
+		t.Fatalf("%s Window not applied. %+v", errPrefix, stats)
+	}
+
+	markets := history.LatestMarkets("X1-A")
+	if len(markets) != 1 || markets[0].TradeGood(TRADE_SYMBOL_FUEL).SellPrice != 70 {
+		t.Fatalf("%s Wrong latest markets. %+v", errPrefix, markets)
+	}
+
+	csvOut := new(strings.Builder)
+	err = history.ExportCSV(csvOut, PriceQuery{TradeSymbol: TRADE_SYMBOL_IRON_ORE})
+	if err != nil {
+		t.Fatalf("%s Exporting CSV. %v", errPrefix, err)
+	}
+	lines := strings.Split(strings.TrimSpace(csvOut.String()), "\n")
+	if len(lines) != 4 || lines[1] != "2024-11-01T12:00:00Z,X1-A-1,IRON_ORE,,,,0,10,8" {
+		t.Fatalf("%s Wrong CSV.\n%s", errPrefix, csvOut.String())
+	}
+
+	jsonOut := new(strings.Builder)
+	err = history.ExportJSON(jsonOut, PriceQuery{})
+	if err != nil {
+		t.Fatalf("%s Exporting JSON. %v", errPrefix, err)
+	}
+	exported := []PriceRecord{}
+	err = json.Unmarshal([]byte(jsonOut.String()), &exported)
+	if err != nil || len(exported) != 6 {
+		t.Fatalf("%s Wrong JSON. %v\n%s", errPrefix, err, jsonOut.String())
+	}
+
+	// A crash mid-write leaves half a line at the end.
+	info, err := os.Stat(filename)
+	if err != nil {
+		t.Fatalf("%s Checking file. %v", errPrefix, err)
+	}
+	goodSize := info.Size()
+	file, err := os.OpenFile(filename, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatalf("%s Opening file. %v", errPrefix, err)
+	}
+	file.WriteString(`{"Timestamp":"2024-11-01T15:00:00Z","WaypointSym`)
+	file.Close()
+
+	history, err = NewFilePriceHistory(filename)
+	if err != nil {
+		t.Fatalf("%s Torn last line wasn't skipped. %v", errPrefix, err)
+	}
+	if records := history.Prices(PriceQuery{}); len(records) != 6 {
+		t.Fatalf("%s Wrong records after torn line. %d", errPrefix, len(records))
+	}
+	if info, err := os.Stat(filename); err != nil || info.Size() != goodSize {
+		t.Fatalf("%s Torn line wasn't cut from the file. %v", errPrefix, err)
+	}
+
+	// So the next record starts on its own line.
+	err = history.Record(Market{
+		Market: api.Market{
+			Symbol:     "X1-A-3",
+			TradeGoods: []MarketTradeGood{{Symbol: TRADE_SYMBOL_FUEL, PurchasePrice: 70, SellPrice: 60}},
+		},
+		ObservedAt: start.Add(3 * time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("%s Recording after torn line. %v", errPrefix, err)
+	}
+	history, err = NewFilePriceHistory(filename)
+	if err != nil {
+		t.Fatalf("%s Reloading after torn line. %v", errPrefix, err)
+	}
+	if _, ok := history.Latest("X1-A-3", TRADE_SYMBOL_FUEL); !ok {
+		t.Fatalf("%s Record after torn line was lost.", errPrefix)
+	}
+
+	// A bad line with good ones after it is real damage.
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("%s Reading file. %v", errPrefix, err)
+	}
+	err = os.WriteFile(filename, append([]byte("not json\n"), data...), 0644)
+	if err != nil {
+		t.Fatalf("%s Writing file. %v", errPrefix, err)
+	}
+	if _, err := NewFilePriceHistory(filename); err == nil {
+		t.Fatalf("%s Bad first line was skipped.", errPrefix)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	errPrefix := "TEST_Register():"
+
+	requests := []*http.Request{}
+	bodies := []map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		json.NewDecoder(r.Body).Decode(&body)
+		requests = append(requests, r)
+		bodies = append(bodies, body)
+
+		switch r.URL.Path {
+		case "/v2/register":
+			fmt.Fprintf(w, `{"data": {
+				"token": %q,
+				"agent": {"symbol": "TEST_USER", "credits": 175000},
+				"faction": {"symbol": "COSMIC"},
+				"ship": {"symbol": "TEST_USER-1"},
+				"ships": [{"symbol": "TEST_USER-2"}]
+			}}`, TEST_USER_TOKEN)
+		case "/v2/agents":
+			fmt.Fprint(w, `{"data": [{"symbol": "A"}, {"symbol": "B"}], "meta": {"total": 7, "page": 2, "limit": 2}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	oldURL := URL_base.String()
+	SetBaseURL(server.URL)
+	defer SetBaseURL(oldURL)
+
+	store, err := NewFileProfileStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("%s Creating profile store. %v", errPrefix, err)
+	}
+
+	result, err := Register(RegisterOptions{Symbol: "TEST_USER", Faction: "COSMIC"}, store)
+	if err != nil {
+		t.Fatalf("%s Registering. %v", errPrefix, err)
+	}
+	if bodies[0]["symbol"] != "TEST_USER" || bodies[0]["faction"] != "COSMIC" || requests[0].Method != "POST" {
+		t.Fatalf("%s Wrong request. %s %v", errPrefix, requests[0].Method, bodies[0])
+	}
+	if result.Agent.Credits != 175000 || len(result.Ships) != 2 || result.Ships[0].Symbol != "TEST_USER-1" {
+		t.Fatalf("%s Wrong result. %+v", errPrefix, result)
+	}
+
+	profiles, err := store.ListProfiles()
+	if err != nil || len(profiles) != 1 {
+		t.Fatalf("%s Profile not saved. %v %v", errPrefix, err, profiles)
+	}
+	if profiles[0].Symbol != "TEST_USER" || profiles[0].Token != TEST_USER_TOKEN ||
+		profiles[0].Faction != "COSMIC" || profiles[0].ResetDate != "2024-10-27" {
+		t.Fatalf("%s Wrong profile. %+v", errPrefix, profiles[0])
+	}
+
+	agents, total, err := GetAgents(2, 2)
+	if err != nil || len(agents) != 2 || total != 7 {
+		t.Fatalf("%s Wrong agents page. %v %d %v", errPrefix, err, total, agents)
+	}
+	query := requests[len(requests)-1].URL.Query()
+	if query.Get("page") != "2" || query.Get("limit") != "2" {
+		t.Fatalf("%s Wrong agents query. %v", errPrefix, query)
+	}
+}
+
+func TestReputationTracker(t *testing.T) {
+	errPrefix := "TEST_ReputationTracker():"
+	filename := t.TempDir() + "/reputation.json"
+	start := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	tracker, err := LoadReputationTracker(filename)
+	if err != nil {
+		t.Fatalf("%s Loading empty tracker. %v", errPrefix, err)
+	}
+
+	changes := tracker.Apply([]FactionReputation{{"COSMIC", 0}, {"VOID", 5}}, "", start)
+	if len(changes) != 2 || changes[1].Before != 0 || changes[1].After != 5 {
+		t.Fatalf("%s First sighting not recorded. %+v", errPrefix, changes)
+	}
+	changes = tracker.Apply([]FactionReputation{{"COSMIC", 3}, {"VOID", 5}}, "C1", start.Add(time.Hour))
+	if len(changes) != 1 || changes[0].Faction != "COSMIC" || changes[0].ContractID != "C1" {
+		t.Fatalf("%s Unchanged faction recorded, or change missed. %+v", errPrefix, changes)
+	}
+	if history := tracker.FactionHistory("COSMIC"); len(history) != 2 || history[1].After != 3 {
+		t.Fatalf("%s Wrong faction history. %+v", errPrefix, history)
+	}
+
+	err = tracker.Save()
+	if err != nil {
+		t.Fatalf("%s Saving. %v", errPrefix, err)
+	}
+	tracker, err = LoadReputationTracker(filename)
+	if err != nil || tracker.Current["COSMIC"] != 3 || len(tracker.History) != 3 {
+		t.Fatalf("%s Tracker didn't survive a reload. %v %+v", errPrefix, err, tracker)
+	}
+
+	// Fulfilling a contract should record what it did to reputation.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/my/contracts/C2/fulfill":
+			fmt.Fprint(w, `{"data": {"contract": {"id": "C2", "accepted": true, "fulfilled": true, "terms": {"payment": {"onFulfilled": 100}}}, "agent": {}}}`)
+		case "/v2/my/factions":
+			fmt.Fprint(w, `{"data": [{"symbol": "COSMIC", "reputation": 10}], "meta": {"total": 1, "page": 1}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	oldURL := URL_base.String()
+	SetBaseURL(server.URL)
+	defer SetBaseURL(oldURL)
+
+	contract := Contract{ID: "C2", Accepted: true, Terms: &ContractTerms{Payment: &ContractPayment{}}}
+	behavior := NewContractBehavior(&contract)
+	behavior.Markets = []Market{}
+	behavior.Reputation = tracker
+	ship := Ship{Ship: api.Ship{Symbol: "TEST_USER-1", Nav: &ShipNav{WaypointSymbol: "X1-A-1"}}}
+
+	step, err := behavior.Step(context.Background(), &ship, "")
+	if err != nil || !step.Done || !behavior.Report.Fulfilled {
+		t.Fatalf("%s Contract not fulfilled. %v %+v", errPrefix, err, behavior.Report)
+	}
+	if len(behavior.Report.Reputation) != 1 || behavior.Report.Reputation[0].After != 10 ||
+		behavior.Report.Reputation[0].ContractID != "C2" || tracker.Current["COSMIC"] != 10 {
+		t.Fatalf("%s Reputation not recorded. %+v", errPrefix, behavior.Report.Reputation)
+	}
+}
+
+func TestAgentWatcher(t *testing.T) {
+	errPrefix := "TEST_AgentWatcher():"
+
+	credits := map[string]int{"RIVAL": 1000}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		symbol := strings.TrimPrefix(r.URL.Path, "/v2/agents/")
+		amount, ok := credits[symbol]
+		if !ok {
+			fmt.Fprint(w, `{"error": {"code": 404, "message": "Agent not found."}}`)
+			return
+		}
+		fmt.Fprintf(w, `{"data": {"symbol": %q, "credits": %d, "shipCount": 3}}`, symbol, amount)
+	}))
+	defer server.Close()
+
+	oldURL := URL_base.String()
+	SetBaseURL(server.URL)
+	defer SetBaseURL(oldURL)
+
+	watcher := NewAgentWatcher([]string{"GONE", "RIVAL"}, time.Minute)
+
+	err := watcher.Snapshot()
+	if err == nil {
+		t.Fatalf("%s Missing agent wasn't reported.", errPrefix)
+	}
+	credits["RIVAL"] = 2500
+	watcher.Snapshot()
+
+	history := watcher.History("RIVAL")
+	if len(history) != 2 || history[0].Credits != 1000 || history[1].Credits != 2500 || history[1].ShipCount != 3 {
+		t.Fatalf("%s Wrong history. %+v", errPrefix, history)
+	}
+	if len(watcher.History("GONE")) != 0 {
+		t.Fatalf("%s Missing agent has a history.", errPrefix)
+	}
+
+	// The copy shouldn't change with the watcher.
+	history[0].Credits = 0
+	if watcher.History("RIVAL")[0].Credits != 1000 {
+		t.Fatalf("%s History isn't a copy.", errPrefix)
+	}
+}
+
+func TestCacheWaypoints(t *testing.T) {
+	errPrefix := "TEST_CacheWaypoints():"
+
+	cacheMutex.Lock()
+	oldCache := dataCache
+	cacheMutex.Unlock()
+	defer SetCache(oldCache)
+
+	cache := NewMemoryCache(nil)
+	SetCache(cache)
+	// Don't ask the server whether it reset.
+	cacheMutex.Lock()
+	cacheResetChecked = time.Now()
+	cacheMutex.Unlock()
+
+	listKey := "X1-A?limit=" + strconv.Itoa(MAX_PAGE_LIMIT)
+	cache.Set(CACHE_SYSTEM_WAYPOINTS, listKey, []Waypoint{
+		{Symbol: "X1-A-1", SystemSymbol: "X1-A", X: 1},
+		{Symbol: "X1-A-3", SystemSymbol: "X1-A", X: 3},
+	})
+
+	cacheWaypoints([]Waypoint{
+		{Symbol: "X1-A-1", SystemSymbol: "X1-A", X: 10},
+		{Symbol: "X1-A-2", SystemSymbol: "X1-A", X: 2},
+		{Symbol: "X1-B-1", SystemSymbol: "X1-B", X: 5},
+	})
+
+	waypoint := Waypoint{}
+	if ok, _ := cache.Get(CACHE_WAYPOINT, "X1-B-1", &waypoint); !ok || waypoint.X != 5 {
+		t.Fatalf("%s Waypoint not cached on its own. %v", errPrefix, waypoint)
+	}
+
+	listed := []Waypoint{}
+	cache.Get(CACHE_SYSTEM_WAYPOINTS, listKey, &listed)
+	xs := map[string]int{}
+	for _, waypoint := range listed {
+		xs[waypoint.Symbol] = waypoint.X
+	}
+	if len(listed) != 3 || xs["X1-A-1"] != 10 || xs["X1-A-2"] != 2 || xs["X1-A-3"] != 3 {
+		t.Fatalf("%s System list not updated. %+v", errPrefix, listed)
+	}
+
+	// A system without a cached list shouldn't get a partial one.
+	if ok, _ := cache.Get(CACHE_SYSTEM_WAYPOINTS, "X1-B?limit="+strconv.Itoa(MAX_PAGE_LIMIT), &listed); ok {
+		t.Fatalf("%s Partial system list cached.", errPrefix)
+	}
+}
+
+func TestNearestNeighbourTour(t *testing.T) {
+	errPrefix := "TEST_NearestNeighbourTour():"
+
+	tour := nearestNeighbourTour(Vector2{0, 0}, map[string]Vector2{
+		"FAR":    {100, 0},
+		"NEAR":   {10, 0},
+		"MIDDLE": {50, 0},
+		"BACK":   {-20, 0},
+	})
+	expected := []string{"NEAR", "BACK", "MIDDLE", "FAR"}
+	if strings.Join(tour, ",") != strings.Join(expected, ",") {
+		t.Fatalf("%s Wrong tour %v, expected %v.", errPrefix, tour, expected)
+	}
+
+	if tour := nearestNeighbourTour(Vector2{}, map[string]Vector2{}); len(tour) != 0 {
+		t.Fatalf("%s Tour of nothing isn't empty. %v", errPrefix, tour)
+	}
+}
+
+func TestShipActionNoContent(t *testing.T) {
+	errPrefix := "TEST_ShipActionNoContent():"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": null}`)
+	}))
+	defer server.Close()
+
+	oldURL := URL_base.String()
+	SetBaseURL(server.URL)
+	defer SetBaseURL(oldURL)
+
+	ship := Ship{
+		Ship: api.Ship{
+			Symbol:  "TEST_USER-1",
+			Modules: []ShipModule{{Symbol: "MODULE_CARGO_HOLD_I"}},
+		},
+	}
+
+	_, err := RemoveModule(&ship, "MODULE_CARGO_HOLD_I", "")
+	if !errors.Is(err, NoContentError) {
+		t.Fatalf("%s Empty response wasn't NoContentError. %v", errPrefix, err)
+	}
+	if len(ship.Modules) != 1 {
+		t.Fatalf("%s Empty response cleared the ship's modules.", errPrefix)
+	}
+}
+
+func TestRefiningOptions(t *testing.T) {
+	errPrefix := "TEST_RefiningOptions():"
+
+	ship := Ship{
+		Ship: api.Ship{
+			Modules: []ShipModule{{Symbol: MODULE_ORE_REFINERY_I}},
+			Cargo: &ShipCargo{Inventory: []ShipCargoItem{
+				{Symbol: TRADE_SYMBOL_IRON_ORE, Units: 30},
+				{Symbol: TRADE_SYMBOL_COPPER_ORE, Units: 29},
+				{Symbol: TRADE_SYMBOL_HYDROCARBON, Units: 60},
+			}},
+		},
+	}
+
+	options := ship.RefiningOptions()
+	if len(options) != 1 || options[0].Produce != TRADE_SYMBOL_IRON {
+		t.Fatalf("%s Ore refinery should only refine iron. %+v", errPrefix, options)
+	}
+
+	ship.Modules = []ShipModule{{Symbol: MODULE_FUEL_REFINERY_I}}
+	options = ship.RefiningOptions()
+	if len(options) != 1 || options[0].Produce != TRADE_SYMBOL_FUEL || options[0].InputUnits != 30 {
+		t.Fatalf("%s Fuel refinery should only refine fuel. %+v", errPrefix, options)
+	}
+
+	ship.Modules = nil
+	if options := ship.RefiningOptions(); len(options) != 0 {
+		t.Fatalf("%s Ship without a refinery can refine. %+v", errPrefix, options)
+	}
+
+	// Every recipe should be one the refine endpoint accepts.
+	for _, recipe := range RefineRecipes {
+		if !recipe.Produce.IsKnown() || recipe.InputUnits <= 0 || len(recipe.Modules) == 0 {
+			t.Fatalf("%s Bad recipe. %+v", errPrefix, recipe)
+		}
+	}
+}
+
+func TestFleetMonitor(t *testing.T) {
+	errPrefix := "TEST_FleetMonitor():"
+
+	ships := []Ship{
+		{Ship: api.Ship{Symbol: "SHIP-1", Crew: &ShipCrew{Current: 4, Required: 6, Morale: 80, Wages: 10}}},
+		{Ship: api.Ship{Symbol: "SHIP-2", Crew: &ShipCrew{Current: 6, Required: 6, Morale: 20, Wages: 5}}},
+		{Ship: api.Ship{Symbol: "PROBE"}},
+	}
+
+	if burn := FleetWageBurn(ships); burn != 70 {
+		t.Fatalf("%s Wrong wage burn %d.", errPrefix, burn)
+	}
+
+	alerts := CrewAlerts(ships, DEFAULT_LOW_MORALE)
+	if len(alerts) != 2 ||
+		alerts[0].Type != FLEET_EVENT_UNDERCREWED || alerts[0].ShipSymbol != "SHIP-1" ||
+		alerts[1].Type != FLEET_EVENT_LOW_MORALE || alerts[1].ShipSymbol != "SHIP-2" {
+		t.Fatalf("%s Wrong alerts. %v", errPrefix, alerts)
+	}
+
+	monitor := NewFleetMonitor("", time.Minute)
+	if events := monitor.check(ships); len(events) != 3 {
+		t.Fatalf("%s First check should send both alerts and the burn. %v", errPrefix, events)
+	}
+	if events := monitor.check(ships); len(events) != 0 {
+		t.Fatalf("%s Nothing changed but events were sent. %v", errPrefix, events)
+	}
+
+	// Crew hired on SHIP-1: no new alert, but the burn changes.
+	ships[0].Crew.Current = 6
+	events := monitor.check(ships)
+	if len(events) != 1 || events[0].Type != FLEET_EVENT_WAGE_BURN {
+		t.Fatalf("%s Expected only a new wage burn. %v", errPrefix, events)
+	}
+
+	// Losing crew again is a new problem.
+	ships[0].Crew.Current = 5
+	events = monitor.check(ships)
+	if len(events) != 2 || events[0].Type != FLEET_EVENT_UNDERCREWED {
+		t.Fatalf("%s Returning problem not sent again. %v", errPrefix, events)
+	}
+}
+
+func TestPlanConstructionSupply(t *testing.T) {
+	errPrefix := "TEST_PlanConstructionSupply():"
+
+	site := Construction{
+		Symbol: "X1-A-GATE",
+		Materials: []ConstructionMaterial{
+			{TradeSymbol: TRADE_SYMBOL_FAB_MATS, Required: 100, Fulfilled: 40},
+			{TradeSymbol: TRADE_SYMBOL_ADVANCED_CIRCUITRY, Required: 50, Fulfilled: 50},
+			{TradeSymbol: TRADE_SYMBOL_QUANTUM_STABILIZERS, Required: 1, Fulfilled: 0},
+		},
+	}
+	markets := []Market{
+		{Market: api.Market{Symbol: "X1-A-FAR", Exports: []TradeGood{{Symbol: TRADE_SYMBOL_FAB_MATS}}}},
+		{
+			Market: api.Market{
+				Symbol:     "X1-A-EXCHANGE",
+				Exchange:   []TradeGood{{Symbol: TRADE_SYMBOL_FAB_MATS}},
+				TradeGoods: []MarketTradeGood{{Symbol: TRADE_SYMBOL_FAB_MATS, PurchasePrice: 900}},
+			},
+		},
+		{Market: api.Market{Symbol: "X1-A-BUYER", Imports: []TradeGood{{Symbol: TRADE_SYMBOL_FAB_MATS}}}},
+		{Market: api.Market{Symbol: "X1-A-UNCHARTED", Exports: []TradeGood{{Symbol: TRADE_SYMBOL_FAB_MATS}}}},
+	}
+	locations := map[string]Vector2{
+		"X1-A-GATE":     {0, 0},
+		"X1-A-FAR":      {100, 0},
+		"X1-A-EXCHANGE": {10, 0},
+		"X1-A-BUYER":    {5, 0},
+	}
+
+	needs := PlanConstructionSupply(&site, markets, locations)
+	if len(needs) != 2 {
+		t.Fatalf("%s Wrong needs. %+v", errPrefix, needs)
+	}
+
+	fabMats := needs[0]
+	if fabMats.TradeSymbol != TRADE_SYMBOL_FAB_MATS || fabMats.Units != 60 || len(fabMats.Sources) != 2 {
+		t.Fatalf("%s Wrong fab mats need. %+v", errPrefix, fabMats)
+	}
+	if fabMats.Sources[0].WaypointSymbol != "X1-A-EXCHANGE" || fabMats.Sources[0].PurchasePrice != 900 ||
+		fabMats.Sources[1].WaypointSymbol != "X1-A-FAR" {
+		t.Fatalf("%s Exchange market not found first. %+v", errPrefix, fabMats.Sources)
+	}
+
+	if len(needs[1].Sources) != 0 {
+		t.Fatalf("%s Unsold material has sources. %+v", errPrefix, needs[1])
+	}
+}
+
+func TestExtractResourcesSurveyBody(t *testing.T) {
+	errPrefix := "TEST_ExtractResourcesSurveyBody():"
+	path := ""
+	body := map[string]any{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body)
+		fmt.Fprint(w, `{"data": {"extraction": {"shipSymbol": "TEST_USER-1", "yield": {"symbol": "IRON_ORE", "units": 5}}}}`)
+	}))
+	defer server.Close()
+
+	oldURL := URL_base.String()
+	SetBaseURL(server.URL)
+	defer SetBaseURL(oldURL)
+
+	survey := &Survey{
+		Signature:  "X1-A-B-1234",
+		Symbol:     "X1-A-B",
+		Deposits:   []SurveyDeposit{{Symbol: string(TRADE_SYMBOL_IRON_ORE)}, {Symbol: string(TRADE_SYMBOL_ICE_WATER)}},
+		Expiration: "2024-11-01T00:00:00Z",
+		Size:       SURVEY_SIZE_MODERATE,
+	}
+
+	result, err := ExtractResources("TEST_USER-1", survey, "")
+	if err != nil {
+		t.Fatalf("%s Extracting. %s", errPrefix, err.Error())
+	}
+	if result.Extraction.Yield.Units != 5 {
+		t.Fatalf("%s Wrong yield. %+v", errPrefix, result.Extraction)
+	}
+	if !strings.HasSuffix(path, "/my/ships/TEST_USER-1/extract/survey") {
+		t.Fatalf("%s Wrong path %s", errPrefix, path)
+	}
+
+	want := map[string]any{
+		"signature": "X1-A-B-1234",
+		"symbol":    "X1-A-B",
+		"deposits": []any{
+			map[string]any{"symbol": "IRON_ORE"},
+			map[string]any{"symbol": "ICE_WATER"},
+		},
+		"expiration": "2024-11-01T00:00:00Z",
+		"size":       "MODERATE",
+	}
+	wantJSON, _ := json.Marshal(want)
+	bodyJSON, _ := json.Marshal(body)
+	if string(bodyJSON) != string(wantJSON) {
+		t.Fatalf("%s Wrong body.\n\tgot  %s\n\twant %s", errPrefix, bodyJSON, wantJSON)
+	}
+}
+
+func TestGetStatus(t *testing.T) {
+	errPrefix := "TEST_GetStatus():"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2":
+			fmt.Fprint(w, `{
+				"status": "SpaceTraders is currently online and available to play",
+				"version": "v2.2.0",
+				"resetDate": "2024-11-10",
+				"stats": {"agents": 12, "ships": 34},
+				"serverResets": {"next": "2024-11-24T16:00:00.000Z", "frequency": "fortnightly"}
+			}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	oldURL := URL_base.String()
+	SetBaseURL(server.URL)
+	defer SetBaseURL(oldURL)
+
+	status, err := GetStatus()
+	if err != nil {
+		t.Fatalf("%s Getting status.\n%s", errPrefix, err.Error())
+	}
+	if status.ResetDate != "2024-11-10" || status.Stats.Agents != 12 ||
+		status.ServerResets.Frequency != "fortnightly" {
+		t.Fatalf("%s Wrong status. %+v", errPrefix, status)
+	}
+}
+
+func TestFileProfileStore(t *testing.T) {
+	errPrefix := "TEST_FileProfileStore():"
+
+	store, err := NewFileProfileStore(t.TempDir() + "/profiles")
+	if err != nil {
+		t.Fatalf("%s Creating profile store. %v", errPrefix, err)
+	}
+
+	profile, err := NewProfile(TEST_USER_TOKEN, "COSMIC")
+	if err != nil {
+		t.Fatalf("%s Creating profile. %v", errPrefix, err)
+	}
+	profile.Stale = true
+
+	err = store.SaveProfile(profile)
+	if err != nil {
+		t.Fatalf("%s Saving profile. %v", errPrefix, err)
+	}
+
+	loaded, err := store.LoadProfile(profile.Symbol)
+	if err != nil {
+		t.Fatalf("%s Loading profile. %v", errPrefix, err)
+	}
+	if *loaded != *profile {
+		t.Fatalf("%s Profile changed on the way through. %+v %+v", errPrefix, loaded, profile)
+	}
+
+	profiles, err := store.ListProfiles()
+	if err != nil || len(profiles) != 1 || *profiles[0] != *profile {
+		t.Fatalf("%s Wrong profile list. %v %v", errPrefix, err, profiles)
+	}
+}
+
+func TestResetWatcher(t *testing.T) {
+	errPrefix := "TEST_ResetWatcher():"
+	resetDate := "2024-10-27"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2":
+			fmt.Fprintf(w, `{"status": "online", "resetDate": %q}`, resetDate)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	oldURL := URL_base.String()
+	SetBaseURL(server.URL)
+	defer SetBaseURL(oldURL)
+
+	store, err := NewFileProfileStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("%s Creating profile store. %v", errPrefix, err)
+	}
+	profile, err := NewProfile(TEST_USER_TOKEN, "COSMIC")
+	if err != nil {
+		t.Fatalf("%s Creating profile. %v", errPrefix, err)
+	}
+	err = store.SaveProfile(profile)
+	if err != nil {
+		t.Fatalf("%s Saving profile. %v", errPrefix, err)
+	}
+
+	notified := 0
+	watcher := &ResetWatcher{
+		Store:   store,
+		OnStale: func(*Profile) { notified++ },
+	}
+
+	stale, err := watcher.Check()
+	if err != nil || len(stale) != 0 {
+		t.Fatalf("%s Profile stale before a reset. %v %v", errPrefix, err, stale)
+	}
+
+	resetDate = "2024-11-10"
+
+	stale, err = watcher.Check()
+	if err != nil || len(stale) != 1 || notified != 1 {
+		t.Fatalf("%s Reset not detected. %v %v %d", errPrefix, err, stale, notified)
+	}
+
+	loaded, err := store.LoadProfile(profile.Symbol)
+	if err != nil || !loaded.Stale {
+		t.Fatalf("%s Stale profile not saved. %v %+v", errPrefix, err, loaded)
+	}
+
+	// Already stale, so it isn't reported again.
+	stale, err = watcher.Check()
+	if err != nil || len(stale) != 0 || notified != 1 {
+		t.Fatalf("%s Stale profile reported twice. %v %v %d", errPrefix, err, stale, notified)
+	}
+}
@@ -0,0 +1,193 @@
+package space_traders_api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type ServerStatus struct {
+	Status      string
+	Version     string
+	ResetDate   string
+	Description string
+	Stats       struct {
+		Accounts  int
+		Agents    int
+		Ships     int
+		Systems   int
+		Waypoints int
+	}
+	Leaderboards struct {
+		MostCredits []struct {
+			AgentSymbol string
+			Credits     int
+		}
+		MostSubmittedCharts []struct {
+			AgentSymbol string
+			ChartCount  int
+		}
+	}
+	ServerResets struct {
+		Next      string
+		Frequency string
+	}
+	Announcements []struct {
+		Title string
+		Body  string
+	}
+	Links []struct {
+		Name string
+		Url  string
+	}
+}
+
+// https://api.spacetraders.io/v2
+// Doesn't need a token.
+func GetStatus() (*ServerStatus, error) {
+	errPrefix := "Getting server status."
+	status := new(ServerStatus)
+
+	req, err := http.NewRequest("GET", apiBaseURL(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s Creating request. %w", errPrefix, err)
+	}
+
+	buf, err := SendRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Sending request.\n%w",
+			errPrefix,
+			err,
+		)
+	}
+
+	err = json.Unmarshal(buf, status)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Unmarshaling response.\n%w",
+			errPrefix,
+			err,
+		)
+	}
+
+	if status.ResetDate == "" {
+		return status, fmt.Errorf(
+			"%s %w No reset date.",
+			errPrefix,
+			NoContentError,
+		)
+	}
+
+	return status, nil
+}
+
+// Compares the server's reset date with the ones stored in a ProfileStore.
+// Profiles from an earlier reset are marked Stale.
+// With Reregister set, stale agents are registered again under the same symbol and faction,
+// using AccountToken if it isn't empty.
+type ResetWatcher struct {
+	Store        ProfileStore
+	Reregister   bool
+	AccountToken string
+	Interval     time.Duration
+	// Called for each profile found stale, after it has been saved.
+	OnStale func(profile *Profile)
+}
+
+// Checks once. Returns the profiles that were stale.
+func (self *ResetWatcher) Check() (stale []*Profile, err error) {
+	errPrefix := "Checking for server reset."
+
+	status, err := GetStatus()
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Getting status.\n%w",
+			errPrefix,
+			err,
+		)
+	}
+
+	profiles, err := self.Store.ListProfiles()
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Listing profiles.\n%w",
+			errPrefix,
+			err,
+		)
+	}
+
+	for _, profile := range profiles {
+		if profile.ResetDate == status.ResetDate {
+			continue
+		}
+		if profile.Stale && !self.Reregister {
+			continue
+		}
+
+		profile.Stale = true
+
+		if self.Reregister {
+			result, err := Register(
+				RegisterOptions{
+					Symbol:       profile.Symbol,
+					Faction:      profile.Faction,
+					AccountToken: self.AccountToken,
+				},
+				nil,
+			)
+			if err != nil {
+				return stale, fmt.Errorf(
+					"%s Re-registering %s.\n%w",
+					errPrefix,
+					profile.Symbol,
+					err,
+				)
+			}
+
+			profile.Token = result.Token
+			profile.ResetDate = status.ResetDate
+			profile.Stale = false
+		}
+
+		err = self.Store.SaveProfile(profile)
+		if err != nil {
+			return stale, fmt.Errorf("%s %w", errPrefix, err)
+		}
+
+		stale = append(stale, profile)
+
+		if self.OnStale != nil {
+			self.OnStale(profile)
+		}
+	}
+
+	return stale, nil
+}
+
+// Checks every Interval until ctx is done.
+// Errors from a single check don't stop it, they're passed to onError if it isn't nil.
+func (self *ResetWatcher) Run(ctx context.Context, onError func(error)) {
+	interval := self.Interval
+	if interval <= 0 {
+		interval = CACHE_RESET_CHECK_INTERVAL
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		_, err := self.Check()
+		if err != nil && onError != nil {
+			onError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -7,41 +7,43 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+
+	"github.com/brendoncdodd/space_traders_api/api"
 )
 
 const MAX_PAGE_LIMIT = 20
 
-type WaypointTrait struct {
-	Symbol      string
-	Name        string
-	Description string
+// A waypoint symbol split into its parts.
+// X1-DF55-20250Z is sector X1, system DF55, waypoint 20250Z.
+type WaypointSymbol struct {
+	Sector   string
+	System   string
+	Waypoint string
 }
 
-type WaypointModifier struct {
-	Symbol      string
-	Name        string
-	Description string
+func ParseWaypointSymbol(symbol string) (WaypointSymbol, error) {
+	parts := strings.SplitN(symbol, "-", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return WaypointSymbol{}, fmt.Errorf(
+			"Parsing waypoint symbol. %q isn't SECTOR-SYSTEM-WAYPOINT.",
+			symbol,
+		)
+	}
+
+	return WaypointSymbol{
+		Sector:   parts[0],
+		System:   parts[1],
+		Waypoint: parts[2],
+	}, nil
 }
 
-type WaypointChart struct {
-	WaypointSymbol string
-	Submittedby    string
-	SubmittedOn    string
+// The symbol of the system the waypoint is in, e.g. X1-DF55.
+func (self WaypointSymbol) SystemSymbol() string {
+	return self.Sector + "-" + self.System
 }
 
-type Waypoint struct {
-	Symbol              string
-	Type                string
-	SystemSymbol        string
-	X                   int
-	Y                   int
-	Orbitals            []struct{ symbol string }
-	Orbits              string
-	Faction             *struct{ symbol string }
-	Traits              []WaypointTrait
-	Modifires           []WaypointModifier
-	Chart               *WaypointChart
-	IsUnderConstruction bool
+func (self WaypointSymbol) String() string {
+	return self.Sector + "-" + self.System + "-" + self.Waypoint
 }
 
 func GetAllWaypointsInSystem(systemSymbol string) (ret []Waypoint, err error) {
@@ -50,7 +52,7 @@ func GetAllWaypointsInSystem(systemSymbol string) (ret []Waypoint, err error) {
 		systemSymbol,
 	)
 
-	ret, err = GetSystemWaypoints(systemSymbol, []string{}, "")
+	ret, err = GetSystemWaypoints(systemSymbol, nil, "")
 	if err != nil {
 		return ret, fmt.Errorf(
 			"%s Getting waypoints in system %s.%w",
@@ -65,19 +67,33 @@ func GetAllWaypointsInSystem(systemSymbol string) (ret []Waypoint, err error) {
 
 func GetSystemWaypoints(
 	systemSymbol string,
-	traits []string,
-	waypointType string,
+	traits []WaypointTraitSymbol,
+	waypointType WaypointType,
 ) (ret []Waypoint, err error) {
-	var pageWaypoints *struct {
-		data []Waypoint
-		meta map[string]int
-	}
+	pageWaypoints := new(struct {
+		Data []Waypoint
+		Meta map[string]int
+	})
 
 	errPrefix := fmt.Sprintf("Getting system waypoints:\n\ttraits\t%v\n\ttype\t%s\n",
 		traits,
 		waypointType,
 	)
 
+	for _, trait := range traits {
+		err = trait.Validate()
+		if err != nil {
+			return ret, fmt.Errorf("%s %w", errPrefix, err)
+		}
+	}
+
+	if waypointType != "" {
+		err = waypointType.Validate()
+		if err != nil {
+			return ret, fmt.Errorf("%s %w", errPrefix, err)
+		}
+	}
+
 	req, err := http.NewRequest(
 		"GET",
 		"https://api.spacetraders.io/v2/systems/"+systemSymbol+"/waypoints",
@@ -97,17 +113,22 @@ func GetSystemWaypoints(
 
 	if traits != nil && len(traits) > 0 {
 		for _, trait := range traits {
-			q.Add("traits", trait)
+			q.Add("traits", string(trait))
 		}
 	}
 
 	if waypointType != "" {
-		q.Add("type", waypointType)
+		q.Add("type", string(waypointType))
 	}
 
 	req.URL.RawQuery = q.Encode()
 	req.Close = true
 
+	cacheKey := systemSymbol + "?" + req.URL.RawQuery
+	if cacheGet(CACHE_SYSTEM_WAYPOINTS, cacheKey, &ret) {
+		return ret, nil
+	}
+
 	buf, err := SendRequest(req)
 	if err != nil {
 		return ret, fmt.Errorf(
@@ -119,7 +140,7 @@ func GetSystemWaypoints(
 	req.Body.Close()
 	req.Body = io.NopCloser(strings.NewReader(""))
 
-	err = json.Unmarshal(buf, &pageWaypoints)
+	err = json.Unmarshal(buf, pageWaypoints)
 	if err != nil {
 		return ret, fmt.Errorf(
 			"%s Unmarshaling JSON for page 1.\n\tlength: %d\n\tJSON:%s\n%w",
@@ -130,13 +151,13 @@ func GetSystemWaypoints(
 		)
 	}
 
-	ret = append(ret, pageWaypoints.data...)
+	ret = append(ret, pageWaypoints.Data...)
 
-	for pageWaypoints.meta["page"]*pageWaypoints.meta["limit"] < pageWaypoints.meta["total"] {
+	for pageWaypoints.Meta["page"]*pageWaypoints.Meta["limit"] < pageWaypoints.Meta["total"] {
 		if q.Has("page") {
 			q.Del("page")
 		}
-		q.Add("page", strconv.Itoa(pageWaypoints.meta["page"]+1))
+		q.Add("page", strconv.Itoa(pageWaypoints.Meta["page"]+1))
 
 		req.URL.RawQuery = q.Encode()
 
@@ -145,11 +166,12 @@ func GetSystemWaypoints(
 			return ret, fmt.Errorf(
 				"%s Sending request for page %d.%w",
 				errPrefix,
-				pageWaypoints.meta["page"]+1,
+				pageWaypoints.Meta["page"]+1,
 				err,
 			)
 		}
 
+		pageWaypoints.Data = nil
 		err = json.Unmarshal(buf, pageWaypoints)
 		if err != nil {
 			return ret, fmt.Errorf(
@@ -163,10 +185,12 @@ func GetSystemWaypoints(
 		req.Body.Close()
 		req.Body = io.NopCloser(strings.NewReader(""))
 
-		ret = append(ret, pageWaypoints.data...)
+		ret = append(ret, pageWaypoints.Data...)
 	}
 	defer req.Body.Close()
 
+	cacheSet(CACHE_SYSTEM_WAYPOINTS, cacheKey, ret)
+
 	return
 }
 
@@ -177,25 +201,21 @@ func GetWaypoint(waypointSymbol string) (*Waypoint, error) {
 		Data  *Waypoint
 		Error *STJsonError
 	})
-	systemSymbol :=
-		strings.Split(waypointSymbol, "-")[0] + "-" +
-			strings.Split(waypointSymbol, "-")[1]
+	symbol, err := ParseWaypointSymbol(waypointSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", errPrefix, err)
+	}
+	systemSymbol := symbol.SystemSymbol()
 
-	req, err := http.NewRequest(
-		"GET",
-		"https://api.spacetraders.io/v2/systems/"+
-			systemSymbol+
-			"/waypoints/"+
-			waypointSymbol,
-		io.NopCloser(strings.NewReader("")),
-	)
+	if cached := new(Waypoint); cacheGet(CACHE_WAYPOINT, waypointSymbol, cached) {
+		return cached, nil
+	}
+
+	req, err := newRequest("GET", "/systems/"+systemSymbol+"/waypoints/"+waypointSymbol, "", nil)
 	if err != nil {
-		return nil, fmt.Errorf(
-			"%s Creating request. %w",
-			errPrefix,
-			err,
-		)
+		return nil, fmt.Errorf("%s %w", errPrefix, err)
 	}
+	defer req.Body.Close()
 
 	buf, err := SendRequest(req)
 	if err != nil {
@@ -221,6 +241,15 @@ func GetWaypoint(waypointSymbol string) (*Waypoint, error) {
 			respObject.Error,
 		)
 	}
+	if respObject.Data == nil {
+		return nil, fmt.Errorf(
+			"%s %w",
+			errPrefix,
+			NoContentError,
+		)
+	}
+
+	cacheSet(CACHE_WAYPOINT, waypointSymbol, respObject.Data)
 
 	return respObject.Data, nil
 }
@@ -240,3 +269,188 @@ func GetWaypointLocation(waypointSymbol string) (Vector2, error) {
 
 	return Vector2{waypoint.X, waypoint.Y}, nil
 }
+
+// Generated from the OpenAPI document.
+type (
+	System           = api.System
+	SystemWaypoint   = api.SystemWaypoint
+	SystemFaction    = api.SystemFaction
+	Waypoint         = api.Waypoint
+	WaypointTrait    = api.WaypointTrait
+	WaypointChart    = api.Chart
+	WaypointOrbital  = api.WaypointOrbital
+	WaypointFaction  = api.WaypointFaction
+	WaypointModifier = api.WaypointModifier
+	JumpGate         = api.JumpGate
+)
+
+// Gets every system in the galaxy, one page at a time.
+// There are a lot of systems, so this takes a while.
+func GetSystems() (systems []System, err error) {
+	errPrefix := "Getting systems."
+	respObject := new(struct {
+		Data  []System
+		Meta  map[string]int
+		Error *STJsonError
+	})
+
+	req, err := newRequest("GET", "/systems", "", nil)
+	if err != nil {
+		return systems, fmt.Errorf("%s %w", errPrefix, err)
+	}
+	defer req.Body.Close()
+
+	for page := 1; respObject.Meta == nil || respObject.Meta["total"] > respObject.Meta["page"]*MAX_PAGE_LIMIT; page++ {
+		q := req.URL.Query()
+		q.Set("limit", strconv.Itoa(MAX_PAGE_LIMIT))
+		q.Set("page", strconv.Itoa(page))
+		req.URL.RawQuery = q.Encode()
+
+		buf, err := SendRequest(req)
+		if err != nil {
+			return systems, fmt.Errorf(
+				"%s Sending request for page %d.\n%w",
+				errPrefix,
+				page,
+				err,
+			)
+		}
+
+		respObject.Data = nil
+		err = json.Unmarshal(buf, respObject)
+		if err != nil {
+			return systems, fmt.Errorf(
+				"%s Unmarshalling JSON page %d.\n%w",
+				errPrefix,
+				page,
+				err,
+			)
+		}
+		if respObject.Error != nil {
+			return systems, fmt.Errorf(
+				"%s spacetraders.io error on page %d.\n%w",
+				errPrefix,
+				page,
+				respObject.Error,
+			)
+		}
+
+		systems = append(systems, respObject.Data...)
+	}
+
+	return systems, nil
+}
+
+// https://api.spacetraders.io/v2/systems/{systemSymbol}
+func GetSystem(systemSymbol string) (*System, error) {
+	errPrefix := "Getting system " + systemSymbol + "."
+	respObject := new(struct {
+		Data  *System
+		Error *STJsonError
+	})
+
+	if cached := new(System); cacheGet(CACHE_SYSTEM, systemSymbol, cached) {
+		return cached, nil
+	}
+
+	req, err := newRequest("GET", "/systems/"+systemSymbol, "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", errPrefix, err)
+	}
+	defer req.Body.Close()
+
+	buf, err := SendRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Sending request.\n%w",
+			errPrefix,
+			err,
+		)
+	}
+
+	err = json.Unmarshal(buf, respObject)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%s Unmarshaling response.\n%w",
+			errPrefix,
+			err,
+		)
+	}
+	if respObject.Error != nil {
+		return respObject.Data, fmt.Errorf(
+			"%s spacetraders.io error. %w",
+			errPrefix,
+			respObject.Error,
+		)
+	}
+	if respObject.Data == nil {
+		return nil, fmt.Errorf(
+			"%s %w",
+			errPrefix,
+			NoContentError,
+		)
+	}
+
+	cacheSet(CACHE_SYSTEM, systemSymbol, respObject.Data)
+
+	return respObject.Data, nil
+}
+
+// https://api.spacetraders.io/v2/systems/{systemSymbol}/waypoints/{waypointSymbol}/jump-gate
+func GetJumpGate(waypointSymbol string) (*JumpGate, error) {
+	errPrefix := "Getting jump gate " + waypointSymbol + "."
+	symbol, err := ParseWaypointSymbol(waypointSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", errPrefix, err)
+	}
+
+	if cached := new(JumpGate); cacheGet(CACHE_JUMP_GATE, waypointSymbol, cached) {
+		return cached, nil
+	}
+
+	gate, err := apiClient("").GetJumpGate(symbol.SystemSymbol(), waypointSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", errPrefix, err)
+	}
+
+	cacheSet(CACHE_JUMP_GATE, waypointSymbol, gate)
+
+	return gate, nil
+}
+
+// Puts waypoints in the cache individually, and updates any cached
+// list of all waypoints in their systems.
+func cacheWaypoints(waypoints []Waypoint) {
+	bySystem := make(map[string][]Waypoint)
+
+	for _, waypoint := range waypoints {
+		cacheSet(CACHE_WAYPOINT, waypoint.Symbol, waypoint)
+		bySystem[waypoint.SystemSymbol] = append(bySystem[waypoint.SystemSymbol], waypoint)
+	}
+
+	for systemSymbol, updated := range bySystem {
+		var cached []Waypoint
+		cacheKey := systemSymbol + "?limit=" + strconv.Itoa(MAX_PAGE_LIMIT)
+
+		if !cacheGet(CACHE_SYSTEM_WAYPOINTS, cacheKey, &cached) {
+			continue
+		}
+
+		for _, waypoint := range updated {
+			found := false
+			for i := range cached {
+				if cached[i].Symbol == waypoint.Symbol {
+					cached[i] = waypoint
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				cached = append(cached, waypoint)
+			}
+		}
+
+		cacheSet(CACHE_SYSTEM_WAYPOINTS, cacheKey, cached)
+	}
+}
@@ -0,0 +1,345 @@
+package space_traders_api
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// Used when the markets given to FindTradeRoutes don't sell fuel.
+const DEFAULT_FUEL_PRICE = 72
+
+// How much a price moves, as a fraction, for every TradeVolume units traded.
+// Low supply moves faster.
+var SupplyPriceImpact = map[SupplyLevel]float64{
+	SUPPLY_SCARCE:   0.08,
+	SUPPLY_LIMITED:  0.05,
+	SUPPLY_MODERATE: 0.03,
+	SUPPLY_HIGH:     0.02,
+	SUPPLY_ABUNDANT: 0.01,
+}
+
+// Fuel used and time taken per unit of distance, by flight mode.
+// Time is multiplied by distance and divided by the engine speed.
+var flightModeFuel = map[ShipNavFlightMode]float64{
+	FLIGHT_MODE_DRIFT:   0,
+	FLIGHT_MODE_STEALTH: 1,
+	FLIGHT_MODE_CRUISE:  1,
+	FLIGHT_MODE_BURN:    2,
+}
+
+var flightModeTime = map[ShipNavFlightMode]float64{
+	FLIGHT_MODE_DRIFT:   250,
+	FLIGHT_MODE_STEALTH: 30,
+	FLIGHT_MODE_CRUISE:  25,
+	FLIGHT_MODE_BURN:    12.5,
+}
+
+// How long a ship with engine speed takes to fly distance.
+func TravelTime(distance float64, speed int, mode ShipNavFlightMode) time.Duration {
+	multiplier, ok := flightModeTime[mode]
+	if !ok {
+		multiplier = flightModeTime[FLIGHT_MODE_CRUISE]
+	}
+	if speed <= 0 {
+		speed = 1
+	}
+
+	seconds := math.Round(math.Max(1, distance))*multiplier/float64(speed) + 15
+
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// How much fuel flying distance takes.
+func FuelCost(distance float64, mode ShipNavFlightMode) int {
+	perUnit, ok := flightModeFuel[mode]
+	if !ok {
+		perUnit = flightModeFuel[FLIGHT_MODE_CRUISE]
+	}
+	if perUnit == 0 {
+		return 1
+	}
+
+	return int(math.Max(1, math.Round(distance*perUnit)))
+}
+
+// What matters about a ship when planning trades.
+type TradeShip struct {
+	Symbol string
+	// Where the ship is now. The trip to the first market counts towards the route.
+	// Leave empty to only count the trip between the markets.
+	WaypointSymbol string
+	CargoCapacity  int
+	// 0 for ships that don't use fuel.
+	FuelCapacity int
+	Speed        int
+}
+
+func NewTradeShip(ship *Ship) TradeShip {
+	tradeShip := TradeShip{Symbol: ship.Symbol}
+	if ship.Nav != nil {
+		tradeShip.WaypointSymbol = ship.Nav.WaypointSymbol
+	}
+	if ship.Cargo != nil {
+		tradeShip.CargoCapacity = ship.Cargo.Capacity
+	}
+	if ship.Fuel != nil {
+		tradeShip.FuelCapacity = ship.Fuel.Capacity
+	}
+	if ship.Engine != nil {
+		tradeShip.Speed = ship.Engine.Speed
+	}
+
+	return tradeShip
+}
+
+type TradeRouteOptions struct {
+	FlightMode ShipNavFlightMode
+	// Credits per unit of fuel. 0 uses the cheapest fuel in the markets.
+	FuelPrice int
+	// Distance between two waypoints. Defaults to comparing GetWaypointLocation,
+	// which only makes sense within a system.
+	// Pass something built on Galaxy.FindPath to plan across systems.
+	Distance func(from string, to string) (float64, error)
+	// Routes that make less than this are left out.
+	MinProfit int
+	// 0 for all of them.
+	MaxResults int
+	// Contracts only. Count goods no market has a price for as free,
+	// instead of making the contract infeasible.
+	AllowUnpriced bool
+}
+
+// Buying a good at one market and selling it at another.
+// Prices are averages over every unit, after the price impact.
+type TradeRoute struct {
+	TradeSymbol    TradeSymbol
+	Buy            string
+	Sell           string
+	Units          int
+	BuyPrice       int
+	SellPrice      int
+	Distance       float64
+	Fuel           int
+	FuelCost       int
+	Duration       time.Duration
+	Profit         int
+	CreditsPerHour float64
+}
+
+func (self TradeRoute) String() string {
+	return fmt.Sprintf(
+		"%d %s %s@%d -> %s@%d\t%dc profit\t%.0fc/h",
+		self.Units,
+		self.TradeSymbol,
+		self.Buy,
+		self.BuyPrice,
+		self.Sell,
+		self.SellPrice,
+		self.Profit,
+		self.CreditsPerHour,
+	)
+}
+
+func waypointDistance(from string, to string) (float64, error) {
+	fromLocation, err := GetWaypointLocation(from)
+	if err != nil {
+		return 0, err
+	}
+	toLocation, err := GetWaypointLocation(to)
+	if err != nil {
+		return 0, err
+	}
+
+	return fromLocation.Distance(toLocation), nil
+}
+
+// What it costs to put fuel units in a ship at fuelPrice a unit.
+// Markets sell FUEL in units of 100 ship fuel, and only whole units.
+func FuelPurchaseCost(fuel int, fuelPrice int) int {
+	if fuel <= 0 {
+		return 0
+	}
+
+	return int(math.Ceil(float64(fuel)/100)) * fuelPrice
+}
+
+// The cheapest fuel in a list of markets, or DEFAULT_FUEL_PRICE.
+func cheapestFuel(markets []Market) int {
+	price := 0
+	for i := range markets {
+		good := markets[i].TradeGood(TRADE_SYMBOL_FUEL)
+		if good == nil || good.PurchasePrice <= 0 {
+			continue
+		}
+		if price == 0 || good.PurchasePrice < price {
+			price = good.PurchasePrice
+		}
+	}
+
+	if price == 0 {
+		return DEFAULT_FUEL_PRICE
+	}
+
+	return price
+}
+
+// Works out how many units are worth trading, batch by batch,
+// with each TradeVolume batch moving both prices.
+// Stops when the next batch wouldn't make money or the hold is full.
+func tradeUnits(buy *MarketTradeGood, sell *MarketTradeGood, capacity int) (units int, cost int, revenue int) {
+	buyPrice := float64(buy.PurchasePrice)
+	sellPrice := float64(sell.SellPrice)
+
+	for units < capacity {
+		batch := capacity - units
+		volume := min(buy.TradeVolume, sell.TradeVolume)
+		if volume > 0 && batch > volume {
+			batch = volume
+		}
+
+		if sellPrice <= buyPrice {
+			break
+		}
+
+		units += batch
+		cost += int(math.Round(buyPrice)) * batch
+		revenue += int(math.Round(sellPrice)) * batch
+
+		buyPrice *= 1 + SupplyPriceImpact[buy.Supply]
+		sellPrice *= 1 - SupplyPriceImpact[sell.Supply]
+	}
+
+	return units, cost, revenue
+}
+
+// Ranks every buy-here-sell-there trade in markets by credits per hour.
+// Only markets with prices, which means ones a ship has seen, are considered.
+// A route is left out if the ship couldn't fly a leg of it on a full tank.
+func FindTradeRoutes(markets []Market, ship TradeShip, options TradeRouteOptions) ([]TradeRoute, error) {
+	errPrefix := "Finding trade routes."
+	routes := []TradeRoute{}
+
+	if ship.CargoCapacity <= 0 {
+		return routes, fmt.Errorf("%s Ship has no cargo capacity.", errPrefix)
+	}
+
+	mode := options.FlightMode
+	if mode == "" {
+		mode = FLIGHT_MODE_CRUISE
+	}
+	fuelPrice := options.FuelPrice
+	if fuelPrice <= 0 {
+		fuelPrice = cheapestFuel(markets)
+	}
+	distance := options.Distance
+	if distance == nil {
+		distance = waypointDistance
+	}
+
+	// Legs are shared by every good, so only work each out once.
+	type leg struct {
+		distance float64
+		err      error
+	}
+	legs := make(map[[2]string]leg)
+	legDistance := func(from string, to string) (float64, error) {
+		if from == "" || from == to {
+			return 0, nil
+		}
+		key := [2]string{from, to}
+		if known, ok := legs[key]; ok {
+			return known.distance, known.err
+		}
+		d, err := distance(from, to)
+		legs[key] = leg{d, err}
+		return d, err
+	}
+
+	for buyIndex := range markets {
+		buyMarket := &markets[buyIndex]
+
+		toBuy, err := legDistance(ship.WaypointSymbol, buyMarket.Symbol)
+		if err != nil {
+			continue
+		}
+
+		for _, buy := range buyMarket.TradeGoods {
+			if buy.PurchasePrice <= 0 {
+				continue
+			}
+
+			for sellIndex := range markets {
+				sellMarket := &markets[sellIndex]
+				if sellIndex == buyIndex {
+					continue
+				}
+
+				sell := sellMarket.TradeGood(buy.Symbol)
+				if sell == nil || sell.SellPrice <= buy.PurchasePrice {
+					continue
+				}
+
+				toSell, err := legDistance(buyMarket.Symbol, sellMarket.Symbol)
+				if err != nil {
+					continue
+				}
+
+				fuel := 0
+				if ship.FuelCapacity > 0 {
+					toBuyFuel := 0
+					if toBuy > 0 {
+						toBuyFuel = FuelCost(toBuy, mode)
+					}
+					toSellFuel := FuelCost(toSell, mode)
+					if toBuyFuel > ship.FuelCapacity || toSellFuel > ship.FuelCapacity {
+						continue
+					}
+					fuel = toBuyFuel + toSellFuel
+				}
+
+				units, cost, revenue := tradeUnits(&buy, sell, ship.CargoCapacity)
+				if units == 0 {
+					continue
+				}
+
+				duration := TravelTime(toSell, ship.Speed, mode)
+				if toBuy > 0 {
+					duration += TravelTime(toBuy, ship.Speed, mode)
+				}
+
+				route := TradeRoute{
+					TradeSymbol: buy.Symbol,
+					Buy:         buyMarket.Symbol,
+					Sell:        sellMarket.Symbol,
+					Units:       units,
+					BuyPrice:    cost / units,
+					SellPrice:   revenue / units,
+					Distance:    toBuy + toSell,
+					Fuel:        fuel,
+					FuelCost:    FuelPurchaseCost(fuel, fuelPrice),
+					Duration:    duration,
+				}
+				route.Profit = revenue - cost - route.FuelCost
+				route.CreditsPerHour = float64(route.Profit) / duration.Hours()
+
+				if route.Profit <= 0 || route.Profit < options.MinProfit {
+					continue
+				}
+
+				routes = append(routes, route)
+			}
+		}
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].CreditsPerHour > routes[j].CreditsPerHour
+	})
+
+	if options.MaxResults > 0 && len(routes) > options.MaxResults {
+		routes = routes[:options.MaxResults]
+	}
+
+	return routes, nil
+}